@@ -6,6 +6,7 @@ require (
 	github.com/jinzhu/inflection v1.0.0
 	github.com/sqlc-dev/plugin-sdk-go v1.23.0
 	golang.org/x/text v0.12.0
+	google.golang.org/protobuf v1.31.0
 )
 
 require (
@@ -14,5 +15,4 @@ require (
 	golang.org/x/sys v0.11.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20230822172742-b8732ec3820d // indirect
 	google.golang.org/grpc v1.59.0 // indirect
-	google.golang.org/protobuf v1.31.0 // indirect
 )
@@ -0,0 +1,177 @@
+package zig
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sqlc-dev/plugin-sdk-go/metadata"
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+const annotationPrefix = "@zig"
+
+var annotationKeys = []string{
+	"param_struct",
+	"pg_error_unions",
+	"query_parameter_limit",
+	"query_timeout_ms",
+	"route",
+	"unmanaged_allocations",
+	"use_context",
+}
+
+// parseAnnotations applies the `@zig` annotations found in the comments of a
+// query to a copy of conf. Annotations take the form of space separated
+// key=value pairs, with boolean options also accepted as a bare key:
+//
+//	-- @zig use_context=true query_parameter_limit=1
+//	-- @zig param_struct
+//
+// The remaining comments, with annotation lines removed, are returned alongside
+// the resulting configuration. Every invalid annotation is reported.
+func parseAnnotations(conf Config, comments []string) (Config, []string, error) {
+	var docs []string
+	var errs []error
+	for _, comment := range comments {
+		fields := strings.Fields(comment)
+		if len(fields) == 0 || fields[0] != annotationPrefix {
+			docs = append(docs, comment)
+			continue
+		}
+		for _, field := range fields[1:] {
+			key, value, hasValue := strings.Cut(field, "=")
+			if err := applyAnnotation(&conf, key, value, hasValue); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return conf, nil, errors.Join(errs...)
+	}
+	return conf, docs, nil
+}
+
+// returnsRows reports whether queries of the command return rows.
+func returnsRows(cmd string) bool {
+	return cmd == metadata.CmdOne || cmd == metadata.CmdMany
+}
+
+// validateAnnotations checks the `@zig` annotations of every query, so that
+// they are reported together with the errors in the plugin options.
+func validateAnnotations(conf Config, req *plugin.GenerateRequest) error {
+	var errs []error
+	for _, query := range req.Queries {
+		if query.GetName() == "" || query.GetCmd() == "" {
+			continue
+		}
+		qconf, _, err := parseAnnotations(conf, query.GetComments())
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, err := range joined.Unwrap() {
+				errs = append(errs, fmt.Errorf("query %s: %w", query.GetName(), err))
+			}
+		}
+		// The plugin option itself is already rejected by Validate
+		if qconf.PGErrorUnions && !conf.PGErrorUnions && req.GetSettings().GetEngine() != enginePostgres {
+			errs = append(errs, fmt.Errorf("query %s: pg_error_unions is not supported for %s", query.GetName(), req.GetSettings().GetEngine()))
+		}
+		// Methods without results would take an allocator for the error only
+		if qconf.PGErrorUnions && qconf.UnmanagedAllocations && !qconf.UseContext && !returnsRows(query.GetCmd()) &&
+			!(conf.PGErrorUnions && conf.UnmanagedAllocations && !conf.UseContext) {
+			errs = append(errs, fmt.Errorf("query %s: pg_error_unions is not supported with unmanaged_allocations for %s queries", query.GetName(), query.GetCmd()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func applyAnnotation(conf *Config, key, value string, hasValue bool) error {
+	parseBool := func() (bool, error) {
+		if !hasValue {
+			return true, nil
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return false, fmt.Errorf("invalid value for annotation %s: %q", key, value)
+		}
+		return b, nil
+	}
+	var err error
+	switch key {
+	case "query_parameter_limit":
+		var limit int
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit < 1 {
+			return fmt.Errorf("invalid value for annotation %s: %q", key, value)
+		}
+		conf.QueryParameterLimit = limit
+	case "query_timeout_ms":
+		var timeout int
+		timeout, err = strconv.Atoi(value)
+		if err != nil || timeout < 0 {
+			return fmt.Errorf("invalid value for annotation %s: %q", key, value)
+		}
+		conf.QueryTimeoutMs = timeout
+	case "param_struct":
+		var paramStruct bool
+		if paramStruct, err = parseBool(); err == nil && paramStruct {
+			// A limit of zero moves any parameters into a struct
+			conf.QueryParameterLimit = 0
+		}
+	case "unmanaged_allocations":
+		conf.UnmanagedAllocations, err = parseBool()
+	case "use_context":
+		conf.UseContext, err = parseBool()
+	case "pg_error_unions":
+		conf.PGErrorUnions, err = parseBool()
+	case "route":
+		if route := Route(value); route.IsValid() {
+			conf.Route = route
+		} else {
+			return fmt.Errorf("invalid value for annotation %s: %q", key, value)
+		}
+	default:
+		return unknownKeyError("annotation", key, annotationKeys)
+	}
+	return err
+}
+
+// columnAnnotations are the flags set by `@zig` annotations in column
+// comments.
+type columnAnnotations struct {
+	Redact     bool
+	PrimaryKey bool
+}
+
+// parseColumnComment extracts the `@zig redact` and `@zig primary_key`
+// annotations from a column comment, returning the remaining comment and the
+// flags that were set. Lines with other content are kept in the comment.
+func parseColumnComment(comment string) (string, columnAnnotations) {
+	var lines []string
+	var flags columnAnnotations
+	for _, line := range strings.Split(comment, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != annotationPrefix {
+			lines = append(lines, line)
+			continue
+		}
+		parsed := flags
+		known := true
+		for _, flag := range fields[1:] {
+			switch flag {
+			case "redact":
+				parsed.Redact = true
+			case "primary_key":
+				parsed.PrimaryKey = true
+			default:
+				known = false
+			}
+		}
+		if !known {
+			lines = append(lines, line)
+			continue
+		}
+		flags = parsed
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n")), flags
+}
@@ -0,0 +1,63 @@
+package zig
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+func TestConfigErrorsReportedTogether(t *testing.T) {
+	req := &plugin.GenerateRequest{
+		Settings:      &plugin.Settings{Engine: engineSqlite},
+		PluginOptions: []byte(`{"method_case": "kebab", "rename": {"users.name": "full-name", "users.email": "const"}}`),
+		Queries: []*plugin.Query{
+			{Name: "GetUser", Cmd: ":one", Comments: []string{"@zig route=secondary use_context=maybe"}},
+			{Name: "ListUsers", Cmd: ":many", Comments: []string{"@zig timeout_ms=5"}},
+			{Name: "DeleteUser", Cmd: ":exec", Comments: []string{"@zig pg_error_unions"}},
+		},
+	}
+	_, err := getConfig(req)
+	if err == nil {
+		t.Fatal("getConfig() = nil, want an error")
+	}
+	want := []string{
+		"invalid method_case: kebab",
+		"rename for users.email is a Zig keyword or primitive type: const",
+		"rename for users.name is not a valid Zig identifier: full-name",
+		`query GetUser: invalid value for annotation route: "secondary"`,
+		`query GetUser: invalid value for annotation use_context: "maybe"`,
+		`query ListUsers: unknown annotation "timeout_ms"`,
+		"query DeleteUser: pg_error_unions is not supported for sqlite",
+	}
+	msg := err.Error()
+	last := -1
+	for _, w := range want {
+		idx := strings.Index(msg, w)
+		if idx < 0 {
+			t.Errorf("error is missing %q:\n%s", w, msg)
+			continue
+		}
+		if idx < last {
+			t.Errorf("error reports %q out of order:\n%s", w, msg)
+		}
+		last = idx
+	}
+}
+
+func TestAnnotatedUnionsWithUnmanagedAllocations(t *testing.T) {
+	req := &plugin.GenerateRequest{
+		Settings:      &plugin.Settings{Engine: enginePostgres},
+		PluginOptions: []byte(`{"unmanaged_allocations": true}`),
+		Queries: []*plugin.Query{
+			{Name: "GetUser", Cmd: ":one", Comments: []string{"@zig pg_error_unions"}},
+			{Name: "DeleteUser", Cmd: ":exec", Comments: []string{"@zig pg_error_unions"}},
+			{Name: "TouchUser", Cmd: ":exec", Comments: []string{"@zig pg_error_unions unmanaged_allocations=false"}},
+		},
+	}
+	_, err := getConfig(req)
+	want := "invalid plugin options:\nquery DeleteUser: pg_error_unions is not supported with unmanaged_allocations for :exec queries"
+	if err == nil || err.Error() != want {
+		t.Errorf("getConfig() = %v, want %q", err, want)
+	}
+}
@@ -0,0 +1,134 @@
+package zig
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	buildModuleFilename  = "build_module.zig"
+	dependenciesFilename = "dependencies.zon"
+)
+
+// dependency is a package fetched by the Zig package manager.
+type dependency struct {
+	URL  string
+	Hash string
+}
+
+// backendVersion is a version of a backend the generated code can be written
+// for, along with the API differences the templates switch on.
+type backendVersion struct {
+	// The name given to backend_version: a commit of at least 7 characters
+	// for pinned versions
+	Name string
+	// The Zig release the backend is built with
+	Zig ZigVersion
+	// The package pinned by the build module, if any
+	Dependency *dependency
+
+	// pg.zig: a connection acquired from a pool is released through the pool
+	// rather than with conn.release()
+	PoolRelease bool
+	// pg.zig: result.deinit() drains the rows left unread, rather than
+	// requiring result.drain()
+	DeinitDrains bool
+	// zqlite.zig: the type of blob values
+	BlobType string
+}
+
+// backendVersions are the versions of the backends the templates support, with
+// the default for each Zig release first. The pinned versions are tested by the
+// end-to-end tests.
+var backendVersions = map[Backend][]backendVersion{
+	PGZigBackend: {
+		{
+			Name: "0110cfdf387403a5a326115b5184861c4604d711",
+			Zig:  Zig014,
+			Dependency: &dependency{
+				URL:  "git+https://github.com/karlseguin/pg.zig#0110cfdf387403a5a326115b5184861c4604d711",
+				Hash: "12205019ce2bc2e08c76352ea37a14600d412e5e0ecdd7ddd27b4e83a62f37d8ba94",
+			},
+			PoolRelease:  true,
+			DeinitDrains: true,
+		},
+		{
+			Name: zig013BackendVersion,
+			Zig:  Zig013,
+		},
+	},
+	ZqliteBackend: {
+		{
+			Name: "61568e7d59ac7d05ce8e32c287b26bfbab0126a6",
+			Zig:  Zig014,
+			Dependency: &dependency{
+				URL:  "git+https://github.com/karlseguin/zqlite.zig?ref=master#61568e7d59ac7d05ce8e32c287b26bfbab0126a6",
+				Hash: "122003755f1656fff4295cbee88fbc70407650fa815187f621bedbdf6fd0a1da83e6",
+			},
+			BlobType: "zqlite.Blob",
+		},
+		{
+			Name:     zig013BackendVersion,
+			Zig:      Zig013,
+			BlobType: "[]const u8",
+		},
+	},
+}
+
+// zig013BackendVersion is the backend_version selecting the API of the backend
+// releases built with Zig 0.13.
+const zig013BackendVersion = "zig-0.13"
+
+// backendVersion returns the version of the backend selected by
+// backend_version, which defaults to the first version built with
+// zig_version. Commits may be given as a prefix of at least 7 characters.
+func (c Config) backendVersion() (backendVersion, bool) {
+	for _, version := range backendVersions[c.Backend] {
+		switch {
+		case c.BackendVersion == "" && version.Zig == c.ZigVersion,
+			c.BackendVersion == version.Name,
+			version.Dependency != nil && len(c.BackendVersion) >= 7 && strings.HasPrefix(version.Name, c.BackendVersion):
+			return version, true
+		}
+	}
+	return backendVersion{}, false
+}
+
+// shortName returns the name of a version as shown in errors and comments.
+func (v backendVersion) shortName() string {
+	if v.Dependency != nil {
+		return v.Name[:7]
+	}
+	return v.Name
+}
+
+// validateBackendVersion rejects unknown backend versions and versions built
+// with another Zig release than zig_version.
+func (c Config) validateBackendVersion() []error {
+	version, ok := c.backendVersion()
+	if !ok {
+		var names []string
+		for _, version := range backendVersions[c.Backend] {
+			names = append(names, version.shortName())
+		}
+		return []error{fmt.Errorf("unsupported backend_version for %s: %s (supported: %s)", c.Backend, c.BackendVersion, strings.Join(names, ", "))}
+	}
+	var errs []error
+	if version.Zig != c.ZigVersion {
+		errs = append(errs, fmt.Errorf("%s %s requires zig_version %s", c.Backend, version.shortName(), version.Zig))
+	}
+	if c.EmitBuildModule && version.Dependency == nil {
+		errs = append(errs, fmt.Errorf("emit_build_module requires a pinned backend_version, %s %s is not pinned", c.Backend, version.shortName()))
+	}
+	return errs
+}
+
+// outputDir returns the output directory of the generated code relative to
+// the sqlc configuration, which usually sits next to build.zig.
+func outputDir(out string) string {
+	if out == "" {
+		return "."
+	}
+	return filepath.ToSlash(filepath.Clean(out))
+}
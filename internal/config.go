@@ -1,7 +1,13 @@
 package zig
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
 
 	"github.com/sqlc-dev/plugin-sdk-go/plugin"
 )
@@ -12,14 +18,47 @@ const (
 )
 
 type Config struct {
-	Backend                     Backend  `json:"backend"`
-	EmitExactTableNames         bool     `json:"emit_exact_table_names"`
-	InflectionExcludeTableNames []string `json:"inflection_exclude_table_names"`
-	QueryParameterLimit         int      `json:"query_parameter_limit"`
-	PublicQueryStings           bool     `json:"public_query_strings"`
-	UnmanagedAllocations        bool     `json:"unmanaged_allocations"`
-	UseContext                  bool     `json:"use_context"`
-	PGErrorUnions               bool     `json:"pg_error_unions"`
+	Backend                     Backend           `json:"backend"`
+	EmitExactTableNames         bool              `json:"emit_exact_table_names"`
+	InflectionExcludeTableNames []string          `json:"inflection_exclude_table_names"`
+	QueryParameterLimit         int               `json:"query_parameter_limit"`
+	PublicQueryStings           bool              `json:"public_query_strings"`
+	UnmanagedAllocations        bool              `json:"unmanaged_allocations"`
+	UseContext                  bool              `json:"use_context"`
+	PGErrorUnions               bool              `json:"pg_error_unions"`
+	EmitSchemaNamespaces        bool              `json:"emit_schema_namespaces"`
+	Rename                      map[string]string `json:"rename"`
+	FieldCase                   IdentifierCase    `json:"field_case"`
+	MethodCase                  IdentifierCase    `json:"method_case"`
+	EmitInterface               bool              `json:"emit_interface"`
+	EmitMock                    bool              `json:"emit_mock"`
+	EmitHooks                   bool              `json:"emit_hooks"`
+	EmitRoutedQuerier           bool              `json:"emit_routed_querier"`
+	QueryTimeoutMs              int               `json:"query_timeout_ms"`
+	EmitJSON                    bool              `json:"emit_json"`
+	JSONTagsCase                IdentifierCase    `json:"json_tags_case"`
+	EmitFormat                  bool              `json:"emit_format"`
+	Redact                      []string          `json:"redact"`
+	EmitClone                   bool              `json:"emit_clone"`
+	EmitEql                     bool              `json:"emit_eql"`
+	EmitMetadata                bool              `json:"emit_metadata"`
+	PrimaryKeys                 []string          `json:"primary_keys"`
+	EmitQueryCatalog            bool              `json:"emit_query_catalog"`
+	EmbedSchema                 bool              `json:"embed_schema"`
+	EmitFixtures                bool              `json:"emit_fixtures"`
+	ModelsLayout                ModelsLayout      `json:"models_layout"`
+	OutputModelsFileName        string            `json:"output_models_file_name"`
+	OutputEnumsFileName         string            `json:"output_enums_file_name"`
+	OutputFilesSuffix           string            `json:"output_files_suffix"`
+	EmitRoot                    bool              `json:"emit_root"`
+	EmitBuildModule             bool              `json:"emit_build_module"`
+	ZigVersion                  ZigVersion        `json:"zig_version"`
+	BackendVersion              string            `json:"backend_version"`
+	TemplateOverrides           map[string]string `json:"template_overrides"`
+
+	// Route overrides the pool a query is sent to by the RoutedQuerier. It
+	// is only set with the route annotation.
+	Route Route `json:"-"`
 }
 
 func (c *Config) Default(req *plugin.GenerateRequest) {
@@ -30,16 +69,253 @@ func (c *Config) Default(req *plugin.GenerateRequest) {
 		c.Backend = ZqliteBackend
 	}
 	c.QueryParameterLimit = 3
+	c.FieldCase = CasePreserve
+	c.MethodCase = CaseCamel
+	c.JSONTagsCase = CasePreserve
+	c.ModelsLayout = ModelsLayoutSingle
+	c.OutputModelsFileName = modelsFilename
+	c.OutputFilesSuffix = ".zig"
+	c.ZigVersion = Zig014
+}
+
+// enumsFile returns the name of the file the enums are declared in, or an
+// empty string when they are declared in the models file.
+func (c Config) enumsFile() string {
+	if c.OutputEnumsFileName == "" && c.ModelsLayout == ModelsLayoutPerModel {
+		return enumsFilename
+	}
+	return c.OutputEnumsFileName
+}
+
+// Decode populates the configuration from the JSON encoded plugin options.
+// Unknown keys and invalid values are collected and reported together.
+func (c *Config) Decode(options []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(options, &raw); err != nil {
+		return err
+	}
+	fields := c.optionFields()
+	keys := make([]string, 0, len(raw))
+	for key := range raw {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var errs []error
+	for _, key := range keys {
+		field, ok := fields[key]
+		if !ok {
+			errs = append(errs, unknownKeyError("option", key, optionNames()))
+			continue
+		}
+		if err := json.Unmarshal(raw[key], field.Addr().Interface()); err != nil {
+			errs = append(errs, fmt.Errorf("invalid value for %s: %w", key, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// optionFields returns the addressable fields of the configuration keyed by
+// their option names.
+func (c *Config) optionFields() map[string]reflect.Value {
+	fields := make(map[string]reflect.Value)
+	v := reflect.ValueOf(c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		name, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields[name] = v.Field(i)
+		}
+	}
+	return fields
+}
+
+func optionNames() []string {
+	var names []string
+	for name := range (&Config{}).optionFields() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 func (c *Config) Validate(req *plugin.GenerateRequest) error {
+	var errs []error
 	if !c.Backend.IsValidFor(req) {
-		return fmt.Errorf("invalid backend for %s: %s", req.GetSettings().GetEngine(), c.Backend)
+		errs = append(errs, fmt.Errorf("invalid backend for %s: %s", req.GetSettings().GetEngine(), c.Backend))
 	}
 	if c.QueryParameterLimit < 1 {
-		return fmt.Errorf("query_parameter_limit must be greater than 0")
+		errs = append(errs, fmt.Errorf("query_parameter_limit must be greater than 0"))
+	}
+	if c.QueryTimeoutMs < 0 {
+		errs = append(errs, fmt.Errorf("query_timeout_ms must not be negative"))
+	}
+	if !c.FieldCase.IsValid() {
+		errs = append(errs, fmt.Errorf("invalid field_case: %s", c.FieldCase))
+	}
+	if !c.MethodCase.IsValid() {
+		errs = append(errs, fmt.Errorf("invalid method_case: %s", c.MethodCase))
+	}
+	if !c.JSONTagsCase.IsValid() {
+		errs = append(errs, fmt.Errorf("invalid json_tags_case: %s", c.JSONTagsCase))
+	}
+	if !c.ModelsLayout.IsValid() {
+		errs = append(errs, fmt.Errorf("invalid models_layout: %s", c.ModelsLayout))
+	}
+	for _, option := range []struct{ name, value string }{
+		{"output_models_file_name", c.OutputModelsFileName},
+		{"output_enums_file_name", c.OutputEnumsFileName},
+		{"output_files_suffix", c.OutputFilesSuffix},
+	} {
+		if option.value == "" {
+			continue
+		}
+		if !strings.HasSuffix(option.value, ".zig") {
+			errs = append(errs, fmt.Errorf("%s must end with .zig: %s", option.name, option.value))
+		}
+		if strings.ContainsAny(option.value, `/\`) {
+			errs = append(errs, fmt.Errorf("%s must not contain a path separator: %s", option.name, option.value))
+		}
+	}
+	if c.OutputModelsFileName == "" {
+		errs = append(errs, fmt.Errorf("output_models_file_name must not be empty"))
+	}
+	if c.OutputFilesSuffix == "" {
+		errs = append(errs, fmt.Errorf("output_files_suffix must not be empty"))
+	}
+	errs = append(errs, c.validateOutputFileCollisions(req)...)
+	if c.PGErrorUnions && req.GetSettings().GetEngine() != enginePostgres {
+		errs = append(errs, fmt.Errorf("pg_error_unions is not supported for %s", req.GetSettings().GetEngine()))
+	}
+	if c.PGErrorUnions && c.UnmanagedAllocations && !c.UseContext {
+		errs = append(errs, fmt.Errorf("pg_error_unions is not supported with unmanaged_allocations"))
+	}
+	if c.EmitMock && !c.EmitInterface {
+		errs = append(errs, fmt.Errorf("emit_mock requires emit_interface"))
+	}
+	if c.EmitRoutedQuerier && req.GetSettings().GetEngine() != enginePostgres {
+		errs = append(errs, fmt.Errorf("emit_routed_querier is not supported for %s", req.GetSettings().GetEngine()))
+	}
+	if c.EmitInterface && c.UseContext {
+		errs = append(errs, fmt.Errorf("emit_interface is not supported with use_context"))
+	}
+	if c.EmitBuildModule && !c.EmitRoot {
+		errs = append(errs, fmt.Errorf("emit_build_module requires emit_root"))
+	}
+	if !c.ZigVersion.IsValid() {
+		errs = append(errs, fmt.Errorf("invalid zig_version: %s", c.ZigVersion))
+	} else if c.Backend.IsValidFor(req) {
+		errs = append(errs, c.validateBackendVersion()...)
+	}
+	if c.EmbedSchema {
+		if _, err := schemaFiles(*c, req); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(c.TemplateOverrides) > 0 {
+		// Parse the overrides on their own to report syntax errors and
+		// unknown functions before generating anything
+		t := template.New("")
+		if err := applyTemplateOverrides(t.Funcs(commonTemplateFuncs(t)).Funcs(engineTemplateFuncs(t, req)), c.TemplateOverrides); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	renames := make([]string, 0, len(c.Rename))
+	for from := range c.Rename {
+		renames = append(renames, from)
+	}
+	sort.Strings(renames)
+	for _, from := range renames {
+		if err := validateRename(from, c.Rename[from]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// generatedFilenames are the files written by the plugin under fixed names.
+var generatedFilenames = []string{
+	catalogFilename,
+	rootFilename,
+	fixturesFilename,
+	schemaFilename,
+	buildModuleFilename,
+	dependenciesFilename,
+}
+
+// validateOutputFileCollisions rejects output file names that would overwrite
+// another generated file. The files written under fixed names are reserved
+// whether or not they are enabled.
+func (c *Config) validateOutputFileCollisions(req *plugin.GenerateRequest) []error {
+	files := make(map[string]string)
+	var errs []error
+	add := func(name, source string) {
+		if other, ok := files[name]; ok {
+			errs = append(errs, fmt.Errorf("%s and %s would both be written to %s", other, source, name))
+			return
+		}
+		files[name] = source
+		if c.ModelsLayout == ModelsLayoutPerModel && strings.HasSuffix(name, ".model.zig") {
+			errs = append(errs, fmt.Errorf("%s must not end with .model.zig with the per_model layout: %s", source, name))
+		}
+	}
+	for _, name := range generatedFilenames {
+		files[name] = "the generated " + name
+	}
+	if c.OutputModelsFileName != "" {
+		add(c.OutputModelsFileName, "output_models_file_name")
+	}
+	if c.enumsFile() != "" {
+		add(c.enumsFile(), "output_enums_file_name")
 	}
-	return nil
+	if c.OutputFilesSuffix != "" {
+		seen := make(map[string]bool)
+		for _, query := range req.GetQueries() {
+			if seen[query.GetFilename()] {
+				continue
+			}
+			seen[query.GetFilename()] = true
+			add(query.GetFilename()+c.OutputFilesSuffix, "the queries of "+query.GetFilename())
+		}
+	}
+	return errs
+}
+
+// Route is the pool a RoutedQuerier sends a query to.
+type Route string
+
+const (
+	RoutePrimary Route = "primary"
+	RouteReplica Route = "replica"
+)
+
+func (r Route) IsValid() bool {
+	return r == RoutePrimary || r == RouteReplica
+}
+
+// ModelsLayout is how models and enums are laid out in the output files.
+type ModelsLayout string
+
+const (
+	// All models and enums are declared in models.zig
+	ModelsLayoutSingle ModelsLayout = "single"
+	// Every model is declared in its own file and enums in enums.zig, with
+	// models.zig re-exporting them
+	ModelsLayoutPerModel ModelsLayout = "per_model"
+)
+
+func (l ModelsLayout) IsValid() bool {
+	return l == ModelsLayoutSingle || l == ModelsLayoutPerModel
+}
+
+// ZigVersion is the Zig release the generated code is written for.
+type ZigVersion string
+
+const (
+	Zig013 ZigVersion = "0.13"
+	Zig014 ZigVersion = "0.14"
+)
+
+func (v ZigVersion) IsValid() bool {
+	return v == Zig013 || v == Zig014
 }
 
 type Backend string
@@ -70,3 +346,31 @@ func (b Backend) ImportName() string {
 		return ""
 	}
 }
+
+type IdentifierCase string
+
+const (
+	CasePreserve IdentifierCase = "preserve"
+	CaseSnake    IdentifierCase = "snake"
+	CaseCamel    IdentifierCase = "camel"
+)
+
+func (c IdentifierCase) IsValid() bool {
+	switch c {
+	case CasePreserve, CaseSnake, CaseCamel:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c IdentifierCase) Apply(s string) string {
+	switch c {
+	case CaseSnake:
+		return snakeCase(s)
+	case CaseCamel:
+		return camelCase(s)
+	default:
+		return s
+	}
+}
@@ -0,0 +1,73 @@
+package zig
+
+import (
+	"testing"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+func TestOutputFileCollisions(t *testing.T) {
+	req := &plugin.GenerateRequest{
+		Settings:      &plugin.Settings{Engine: enginePostgres},
+		PluginOptions: []byte(`{"output_files_suffix": ".zig", "models_layout": "per_model"}`),
+		Queries: []*plugin.Query{
+			{Name: "GetUser", Cmd: ":one", Filename: "root"},
+			{Name: "ListUsers", Cmd: ":many", Filename: "root"},
+			{Name: "ListQueries", Cmd: ":many", Filename: "queries"},
+			{Name: "ListEnums", Cmd: ":many", Filename: "enums"},
+			{Name: "ListUserModels", Cmd: ":many", Filename: "user.model"},
+			{Name: "ListOrders", Cmd: ":many", Filename: "orders.sql"},
+		},
+	}
+	_, err := getConfig(req)
+	want := "invalid plugin options:\n" +
+		"the generated root.zig and the queries of root would both be written to root.zig\n" +
+		"the generated queries.zig and the queries of queries would both be written to queries.zig\n" +
+		"output_enums_file_name and the queries of enums would both be written to enums.zig\n" +
+		"the queries of user.model must not end with .model.zig with the per_model layout: user.model.zig"
+	if err == nil || err.Error() != want {
+		t.Fatalf("getConfig() error = %v, want %q", err, want)
+	}
+}
+
+func TestBackendVersion(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		options string
+		want    string
+		wantErr string
+	}{
+		{name: "default", options: `{}`, want: "0110cfdf387403a5a326115b5184861c4604d711"},
+		{name: "default for zig 0.13", options: `{"zig_version": "0.13"}`, want: "zig-0.13"},
+		{name: "commit prefix", options: `{"backend_version": "0110cfdf"}`, want: "0110cfdf387403a5a326115b5184861c4604d711"},
+		{name: "short prefix", options: `{"backend_version": "0110cf"}`, wantErr: "unsupported backend_version for pg.zig: 0110cf (supported: 0110cfd, zig-0.13)"},
+		{name: "zig mismatch", options: `{"backend_version": "zig-0.13"}`, wantErr: "pg.zig zig-0.13 requires zig_version 0.13"},
+		{
+			name:    "unpinned build module",
+			options: `{"zig_version": "0.13", "emit_root": true, "emit_build_module": true}`,
+			wantErr: "emit_build_module requires a pinned backend_version, pg.zig zig-0.13 is not pinned",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &plugin.GenerateRequest{
+				Settings:      &plugin.Settings{Engine: enginePostgres},
+				PluginOptions: []byte(tc.options),
+			}
+			conf, err := getConfig(req)
+			if tc.wantErr != "" {
+				want := "invalid plugin options:\n" + tc.wantErr
+				if err == nil || err.Error() != want {
+					t.Fatalf("getConfig() error = %v, want %q", err, want)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			version, ok := conf.backendVersion()
+			if !ok || version.Name != tc.want {
+				t.Fatalf("backendVersion() = %q, %v, want %q", version.Name, ok, tc.want)
+			}
+		})
+	}
+}
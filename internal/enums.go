@@ -8,35 +8,104 @@ import (
 )
 
 type Enum struct {
-	Name    string
-	ZigName string
-	Comment string
-	Values  []string
+	Name      string
+	ZigName   string
+	Namespace string
+	Comment   string
+	Values    []EnumValue
 }
 
-func buildEnums(req *plugin.GenerateRequest) []Enum {
+// EnumValue is a single member of an Enum. Name is the Zig tag name, Value is
+// the label stored in the database.
+type EnumValue struct {
+	Name  string
+	Value string
+}
+
+// QualifiedName returns the name of the enum relative to the models file,
+// including the namespace it is declared in (if any).
+func (e Enum) QualifiedName() string {
+	return qualifiedName(e.Namespace, e.ZigName)
+}
+
+// Mapped reports whether any of the enum values were renamed, in which case
+// the tag names no longer match the database labels and values have to be
+// converted with the generated fromSql and toSql functions.
+func (e Enum) Mapped() bool {
+	for _, value := range e.Values {
+		if value.Name != value.Value {
+			return true
+		}
+	}
+	return false
+}
+
+func buildEnums(conf Config, req *plugin.GenerateRequest) ([]Enum, error) {
 	var enums []Enum
+	names := newIdentifierSet("enums")
 	for _, schema := range req.GetCatalog().GetSchemas() {
 		for _, enum := range schema.GetEnums() {
-			var enumName string
-			if schema.GetName() == req.GetCatalog().GetDefaultSchema() {
-				enumName = enum.GetName()
-			} else {
-				enumName = fmt.Sprintf("%s_%s", schema.GetName(), enum.GetName())
+			key := tableKey(req.GetCatalog(), &plugin.Identifier{Schema: schema.GetName(), Name: enum.GetName()})
+			zigName, namespace := enumZigName(conf, req.GetCatalog(), schema, enum)
+			if namespace != "" {
+				if err := validateNamespace(namespace); err != nil {
+					return nil, err
+				}
+			}
+			values, err := buildEnumValues(conf, key, enum)
+			if err != nil {
+				return nil, err
+			}
+			e := Enum{
+				Name:      enum.GetName(),
+				ZigName:   zigName,
+				Namespace: namespace,
+				Comment:   enum.GetComment(),
+				Values:    values,
 			}
-			enums = append(enums, Enum{
-				Name:    enum.GetName(),
-				ZigName: modelName(enumName),
-				Comment: enum.GetComment(),
-				Values:  enum.GetVals(),
-			})
+			if err := names.add(key, e.QualifiedName()); err != nil {
+				return nil, err
+			}
+			enums = append(enums, e)
+		}
+	}
+	sort.SliceStable(enums, func(i, j int) bool { return enums[i].QualifiedName() < enums[j].QualifiedName() })
+	return enums, nil
+}
+
+func buildEnumValues(conf Config, key string, enum *plugin.Enum) ([]EnumValue, error) {
+	var values []EnumValue
+	names := newIdentifierSet(fmt.Sprintf("values of enum %s", key))
+	for _, val := range enum.GetVals() {
+		value := EnumValue{
+			Name:  conf.renamed(val, enumValueRenameKey(key, val)),
+			Value: val,
 		}
+		if err := names.add(value.Value, value.Name); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
 	}
-	sort.Slice(enums, func(i, j int) bool { return enums[i].ZigName < enums[j].ZigName })
-	return enums
+	return values, nil
 }
 
-func enumType(catalog *plugin.Catalog, dbType string) string {
+// enumZigName returns the name of the Zig enum declared for a database enum,
+// along with the namespace it is declared in.
+func enumZigName(conf Config, catalog *plugin.Catalog, schema *plugin.Schema, enum *plugin.Enum) (name, namespace string) {
+	key := tableKey(catalog, &plugin.Identifier{Schema: schema.GetName(), Name: enum.GetName()})
+	var enumName string
+	if schema.GetName() == catalog.GetDefaultSchema() {
+		enumName = enum.GetName()
+	} else if conf.EmitSchemaNamespaces {
+		enumName = enum.GetName()
+		namespace = schema.GetName()
+	} else {
+		enumName = fmt.Sprintf("%s_%s", schema.GetName(), enum.GetName())
+	}
+	return conf.renamed(modelName(enumName), enumRenameKey(key)), namespace
+}
+
+func findEnum(catalog *plugin.Catalog, dbType string) (*plugin.Schema, *plugin.Enum) {
 	for _, schema := range catalog.GetSchemas() {
 		if isInternalSchema(schema.GetName()) {
 			continue
@@ -49,15 +118,34 @@ func enumType(catalog *plugin.Catalog, dbType string) string {
 				enumDataType = fmt.Sprintf("%s.%s", schema.GetName(), enum.GetName())
 			}
 			if enumDataType == dbType {
-				mname := func() string {
-					if schema.GetName() == catalog.GetDefaultSchema() {
-						return modelName(enumDataType)
-					}
-					return modelName(fmt.Sprintf("%s_%s", schema.GetName(), enum.GetName()))
-				}()
-				return mname
+				return schema, enum
 			}
 		}
 	}
-	return ""
+	return nil, nil
+}
+
+func enumType(conf Config, catalog *plugin.Catalog, dbType string) string {
+	schema, enum := findEnum(catalog, dbType)
+	if enum == nil {
+		return ""
+	}
+	name, namespace := enumZigName(conf, catalog, schema, enum)
+	return qualifiedName(namespace, name)
+}
+
+// enumMapped reports whether the enum for the given database type has any
+// renamed values.
+func enumMapped(conf Config, catalog *plugin.Catalog, dbType string) bool {
+	schema, enum := findEnum(catalog, dbType)
+	if enum == nil {
+		return false
+	}
+	key := tableKey(catalog, &plugin.Identifier{Schema: schema.GetName(), Name: enum.GetName()})
+	for _, val := range enum.GetVals() {
+		if conf.renamed(val, enumValueRenameKey(key, val)) != val {
+			return true
+		}
+	}
+	return false
 }
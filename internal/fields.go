@@ -8,15 +8,35 @@ import (
 )
 
 type Field struct {
-	Name     string
-	Comment  string
-	ZigType  string
-	Nullable bool
-	Array    bool
-	Index    int
-	Enum     bool
+	Name       string
+	Comment    string
+	ZigType    string
+	Nullable   bool
+	Array      bool
+	Index      int
+	Enum       bool
+	EnumMapped bool
+	// The key of the field in the generated jsonStringify methods
+	JSONName string
+	// How the field is written by the generated jsonStringify methods, one
+	// of the jsonFormat constants
+	JSONFormat string
+	// Whether the value is hidden by the generated format methods
+	Redacted bool
+	// The name and type of the SQL column, as reported by sqlc
+	Column  string
+	SQLType string
+	// Whether the column is part of the primary key of its table
+	PrimaryKey bool
 }
 
+const (
+	jsonFormatDefault   = ""
+	jsonFormatBytes     = "bytes"
+	jsonFormatUUID      = "uuid"
+	jsonFormatTimestamp = "timestamp"
+)
+
 func (f Field) ZigID() string {
 	if f.Enum {
 		return "models." + f.ZigType
@@ -24,35 +44,77 @@ func (f Field) ZigID() string {
 	return f.ZigType
 }
 
-func buildFields(_ Config, req *plugin.GenerateRequest, columns []*plugin.Column) []Field {
+func buildFields(conf Config, req *plugin.GenerateRequest, columns []*plugin.Column) ([]Field, error) {
 	var fields []Field
+	names := newIdentifierSet("columns")
 	for idx, column := range columns {
 		name := column.GetName()
+		jsonName := conf.JSONTagsCase.Apply(name)
 		if name == "" {
 			name = fmt.Sprintf("column_%d", idx)
+			jsonName = name
+		} else {
+			name = fieldName(conf, req, column, name)
 		}
-		zigType, isEnum := zigDataType(req, column)
+		if err := names.add(column.GetName(), name); err != nil {
+			return nil, err
+		}
+		zigType, isEnum := zigDataType(conf, req, column)
+		comment, flags := parseColumnComment(column.GetComment())
 		fields = append(fields, Field{
-			Name:     name,
-			Comment:  column.GetComment(),
-			ZigType:  zigType,
-			Nullable: !column.GetNotNull(),
-			Array:    column.GetIsArray(),
-			Index:    idx,
-			Enum:     isEnum,
+			Name:       name,
+			Comment:    comment,
+			ZigType:    zigType,
+			Nullable:   !column.GetNotNull(),
+			Array:      column.GetIsArray(),
+			Index:      idx,
+			Enum:       isEnum,
+			EnumMapped: isEnum && enumMapped(conf, req.GetCatalog(), dbDataType(column.GetType())),
+			JSONName:   jsonName,
+			JSONFormat: jsonFormat(req, zigType, dbDataType(column.GetType())),
+			Redacted:   flags.Redact || conf.redacted(req.GetCatalog(), column),
+			Column:     column.GetName(),
+			SQLType:    sqlTypeName(column.GetType()),
+			PrimaryKey: flags.PrimaryKey || conf.primaryKey(req.GetCatalog(), column),
 		})
 	}
-	return fields
+	return fields, nil
+}
+
+// jsonFormat returns how a field is written by the generated jsonStringify
+// methods. Binary data is base64 encoded, UUIDs are written in their canonical
+// form and PostgreSQL timestamps, which pg.zig reads as microseconds since the
+// Unix epoch, as RFC 3339 strings.
+func jsonFormat(req *plugin.GenerateRequest, zigType, dbType string) string {
+	switch zigType {
+	case "[]u8", "zqlite.Blob":
+		return jsonFormatBytes
+	case "[16]u8":
+		return jsonFormatUUID
+	}
+	if req.GetSettings().GetEngine() == enginePostgres {
+		switch strings.ToLower(dbType) {
+		case "pg_catalog.timestamp", "pg_catalog.timestamptz", "timestamptz":
+			return jsonFormatTimestamp
+		}
+	}
+	return jsonFormatDefault
+}
+
+// fieldName applies the field_case and rename options to the name generated
+// for a column.
+func fieldName(conf Config, req *plugin.GenerateRequest, column *plugin.Column, name string) string {
+	return conf.renamed(conf.FieldCase.Apply(name), columnRenameKeys(req.GetCatalog(), column)...)
 }
 
-func zigDataType(req *plugin.GenerateRequest, column *plugin.Column) (typeName string, isEnum bool) {
+func zigDataType(conf Config, req *plugin.GenerateRequest, column *plugin.Column) (typeName string, isEnum bool) {
 	dbType := dbDataType(column.GetType())
 	switch req.GetSettings().GetEngine() {
 	case "postgresql":
 		if pgType := postgresqlType(dbType); pgType != "" {
 			return pgType, false
 		}
-		if enumType := enumType(req.GetCatalog(), dbType); enumType != "" {
+		if enumType := enumType(conf, req.GetCatalog(), dbType); enumType != "" {
 			return enumType, true
 		}
 		panic(fmt.Errorf("unsupported postgresql type: %s", dbType))
@@ -70,6 +132,15 @@ func dbDataType(id *plugin.Identifier) string {
 	return fmt.Sprintf("%s.%s", id.GetSchema(), id.GetName())
 }
 
+// sqlTypeName returns the name of a SQL type for the generated metadata. Built
+// in PostgreSQL types are not qualified with the pg_catalog schema.
+func sqlTypeName(id *plugin.Identifier) string {
+	if id.GetSchema() == "pg_catalog" {
+		return id.GetName()
+	}
+	return strings.TrimPrefix(dbDataType(id), "pg_catalog.")
+}
+
 func postgresqlType(dbType string) string {
 	switch strings.ToLower(dbType) {
 	case "serial", "serial4", "pg_catalog.serial":
@@ -0,0 +1,149 @@
+package zig
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+const fixturesFilename = "fixtures.zig"
+
+// fixtureColumns returns the fields of a model inserted by its fixture,
+// leaving out the columns generated by the database.
+func fixtureColumns(s Struct, generated func(Field) bool) []Field {
+	var fields []Field
+	for _, field := range s.Fields {
+		if !generated(field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// pgGeneratedColumn reports whether a PostgreSQL column is filled in by a
+// sequence.
+func pgGeneratedColumn(f Field) bool {
+	switch strings.ToLower(f.SQLType) {
+	case "serial", "serial4", "serial8", "serial2", "bigserial", "smallserial":
+		return true
+	}
+	return false
+}
+
+// sqliteGeneratedColumn reports whether a SQLite column is an alias of the
+// rowid, which is the case for primary keys declared as INTEGER. sqlc does not
+// expose primary keys, so INTEGER columns named id are taken as one too.
+func sqliteGeneratedColumn(f Field) bool {
+	if !strings.EqualFold(f.SQLType, "integer") {
+		return false
+	}
+	return f.PrimaryKey || f.Column == "id"
+}
+
+// checkFixtureColumns rejects the models with columns a fixture cannot bind.
+// Like query parameters, enum arrays with renamed values would be bound using
+// the Zig tag names instead of the database labels.
+func checkFixtureColumns(models []Struct) error {
+	for _, model := range models {
+		for _, field := range model.Fields {
+			if field.EnumMapped && field.Array {
+				return fmt.Errorf("emit_fixtures: column %s of table %s: arrays of enums with renamed values are not supported", field.Column, model.ID.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// fixtureType returns the type of a fixture field, given the type binding the
+// base type of the column.
+func fixtureType(f Field, baseType string) string {
+	if f.Array {
+		baseType = "[]const " + baseType
+	}
+	if f.Nullable {
+		baseType = "?" + baseType
+	}
+	return baseType
+}
+
+// fixtureDefault returns the deterministic value a fixture field defaults to.
+// Nullable columns default to null, text columns to their name and everything
+// else to the zero value of its type.
+func fixtureDefault(f Field, baseType string) string {
+	if f.Nullable {
+		return "null"
+	}
+	if f.Array {
+		return "&.{}"
+	}
+	if f.Enum {
+		return "@enumFromInt(0)"
+	}
+	switch baseType {
+	case "bool":
+		return "false"
+	case "[16]u8":
+		return "[_]u8{0} ** 16"
+	case "[]const u8", "[]u8":
+		switch strings.ToLower(f.SQLType) {
+		case "json", "jsonb":
+			return `"{}"`
+		case "inet", "cidr":
+			return `"127.0.0.1"`
+		case "macaddr":
+			return `"00:00:00:00:00:00"`
+		case "macaddr8":
+			return `"00:00:00:00:00:00:00:00"`
+		case "bytea", "blob":
+			return `""`
+		}
+		return fmt.Sprintf("%q", f.Column)
+	}
+	return "0"
+}
+
+// fixtureTable returns the quoted name of the table of a model.
+func fixtureTable(catalog *plugin.Catalog, s Struct) string {
+	if s.ID.Schema == "" || s.ID.Schema == catalog.GetDefaultSchema() {
+		return fmt.Sprintf(`\"%s\"`, s.ID.Name)
+	}
+	return fmt.Sprintf(`\"%s\".\"%s\"`, s.ID.Schema, s.ID.Name)
+}
+
+// fixtureInsertSQL returns the statement inserting the fields of a fixture.
+// placeholder formats the placeholder of the parameter at a one based index.
+func fixtureInsertSQL(table string, fields []Field, placeholder func(int) string) string {
+	if len(fields) == 0 {
+		return fmt.Sprintf("INSERT INTO %s DEFAULT VALUES", table)
+	}
+	columns := make([]string, 0, len(fields))
+	values := make([]string, 0, len(fields))
+	for i, field := range fields {
+		columns = append(columns, fmt.Sprintf(`\"%s\"`, field.Column))
+		values = append(values, placeholder(i+1))
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", table, strings.Join(columns, ", "), strings.Join(values, ", "))
+}
+
+// pgFixtureBaseType returns the type binding the base type of a PostgreSQL
+// column, matching the types taken by the query methods.
+func pgFixtureBaseType(f Field) string {
+	switch f.ZigType {
+	case "pg.Numeric":
+		return "f64"
+	case "pg.Cidr", "[]u8":
+		return "[]const u8"
+	default:
+		return f.ZigID()
+	}
+}
+
+// sqliteFixtureBaseType returns the type binding the base type of a SQLite
+// column, matching the types taken by the query methods.
+func sqliteFixtureBaseType(f Field) string {
+	if f.ZigType == "zqlite.Blob" {
+		return "[]const u8"
+	}
+	return f.ZigID()
+}
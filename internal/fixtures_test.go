@@ -0,0 +1,40 @@
+package zig
+
+import "testing"
+
+func TestSqliteGeneratedColumn(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		field Field
+		want  bool
+	}{
+		{"id", Field{Column: "id", SQLType: "INTEGER", ZigType: "i64"}, true},
+		{"primary key", Field{Column: "user_id", SQLType: "integer", ZigType: "i64", PrimaryKey: true}, true},
+		{"bigint primary key", Field{Column: "id", SQLType: "BIGINT", ZigType: "i64", PrimaryKey: true}, false},
+		{"integer column", Field{Column: "user_id", SQLType: "INTEGER", ZigType: "i64"}, false},
+		{"text id", Field{Column: "id", SQLType: "TEXT", ZigType: "[]const u8"}, false},
+	} {
+		if got := sqliteGeneratedColumn(tc.field); got != tc.want {
+			t.Errorf("%s: sqliteGeneratedColumn() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestCheckFixtureColumns(t *testing.T) {
+	model := Struct{
+		ID: Identifier{Name: "tasks"},
+		Fields: []Field{
+			{Name: "status", Column: "status", Enum: true, EnumMapped: true},
+			{Name: "history", Column: "history", Enum: true, EnumMapped: true, Array: true},
+		},
+	}
+	err := checkFixtureColumns([]Struct{model})
+	want := "emit_fixtures: column history of table tasks: arrays of enums with renamed values are not supported"
+	if err == nil || err.Error() != want {
+		t.Errorf("checkFixtureColumns() = %v, want %q", err, want)
+	}
+	model.Fields = model.Fields[:1]
+	if err := checkFixtureColumns([]Struct{model}); err != nil {
+		t.Errorf("checkFixtureColumns() = %v, want nil", err)
+	}
+}
@@ -4,14 +4,16 @@ import (
 	"bytes"
 	"context"
 	"embed"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"path"
+	"sort"
 	"text/template"
 
 	"github.com/sqlc-dev/plugin-sdk-go/plugin"
 )
 
-//go:embed templates/**/*.gotmpl
+//go:embed templates/*.gotmpl templates/**/*.gotmpl
 var templates embed.FS
 
 func Generate(_ context.Context, req *plugin.GenerateRequest) (*plugin.GenerateResponse, error) {
@@ -24,8 +26,17 @@ func Generate(_ context.Context, req *plugin.GenerateRequest) (*plugin.GenerateR
 		return nil, err
 	}
 
-	models := buildModels(conf, req)
-	enums := buildEnums(req)
+	models, err := buildModels(conf, req)
+	if err != nil {
+		return nil, err
+	}
+	enums, err := buildEnums(conf, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkModelsFileNames(models, enums); err != nil {
+		return nil, err
+	}
 	queries, err := buildQueries(conf, req, models)
 	if err != nil {
 		return nil, err
@@ -51,7 +62,13 @@ func validateRequest(req *plugin.GenerateRequest) error {
 }
 
 func renderSourceFiles(conf Config, req *plugin.GenerateRequest, models []Struct, enums []Enum, queries []Query) ([]*plugin.File, error) {
-	modelsFile, err := renderModels(conf, req, models, enums)
+	var modelFiles []*plugin.File
+	var err error
+	if conf.ModelsLayout == ModelsLayoutPerModel {
+		modelFiles, err = renderModelFiles(conf, req, models, enums)
+	} else {
+		modelFiles, err = renderModels(conf, req, models, enums)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -59,16 +76,68 @@ func renderSourceFiles(conf Config, req *plugin.GenerateRequest, models []Struct
 	if err != nil {
 		return nil, err
 	}
-	return append(queryFiles, modelsFile), nil
+	files := append(queryFiles, modelFiles...)
+	if conf.EmitQueryCatalog {
+		catalogFile, err := renderCatalog(conf, req, queryFiles)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, catalogFile)
+	}
+	if conf.EmitFixtures {
+		fixturesFile, err := renderFixtures(conf, req, models, enums)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, fixturesFile)
+	}
+	if conf.EmitRoot {
+		rootFile, err := renderRoot(conf, req, queries, models, enums)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, rootFile)
+	}
+	if conf.EmitBuildModule {
+		buildFiles, err := renderBuildModule(conf, req)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, buildFiles...)
+	}
+	if conf.EmbedSchema {
+		schemaFile, err := renderSchema(conf, req)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, schemaFile)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	return files, nil
 }
 
-const modelsFilename = "models.zig"
+const (
+	modelsFilename  = "models.zig"
+	catalogFilename = "queries.zig"
+)
 
-func renderModels(conf Config, req *plugin.GenerateRequest, models []Struct, enums []Enum) (*plugin.File, error) {
-	t, err := newTemplate(req, templateModels)
+// renderModels renders the models file of the single layout, along with the
+// enums file when the enums are declared in a file of their own.
+func renderModels(conf Config, req *plugin.GenerateRequest, models []Struct, enums []Enum) ([]*plugin.File, error) {
+	t, err := newTemplate(conf, req, templateModels)
 	if err != nil {
 		return nil, err
 	}
+	var files []*plugin.File
+	var enumsFile string
+	if conf.enumsFile() != "" && len(enums) > 0 {
+		file, err := renderEnums(conf, req, t, enums)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+		enumsFile = file.Name
+	}
 	var modelsFile bytes.Buffer
 	if err = t.Execute(&modelsFile, map[string]any{
 		"Config":       conf,
@@ -76,78 +145,401 @@ func renderModels(conf Config, req *plugin.GenerateRequest, models []Struct, enu
 		"DBImportName": conf.Backend.ImportName(),
 		"Models":       models,
 		"Enums":        enums,
+		"Namespaces":   buildNamespaces(models, enums),
+		"EnumsFile":    enumsFile,
 	}); err != nil {
 		return nil, err
 	}
-	return &plugin.File{
-		Name:     modelsFilename,
+	return append(files, &plugin.File{
+		Name:     conf.OutputModelsFileName,
 		Contents: modelsFile.Bytes(),
+	}), nil
+}
+
+// renderEnums renders the file declaring the enums on their own with the
+// models template.
+func renderEnums(conf Config, req *plugin.GenerateRequest, t *template.Template, enums []Enum) (*plugin.File, error) {
+	var enumsFile bytes.Buffer
+	if err := t.Execute(&enumsFile, map[string]any{
+		"Config":       conf,
+		"SQLCVersion":  req.GetSqlcVersion(),
+		"DBImportName": conf.Backend.ImportName(),
+		"Enums":        enums,
+		"Namespaces":   buildNamespaces(nil, enums),
+	}); err != nil {
+		return nil, err
+	}
+	return &plugin.File{
+		Name:     conf.enumsFile(),
+		Contents: enumsFile.Bytes(),
 	}, nil
 }
 
-func renderQueries(conf Config, req *plugin.GenerateRequest, queries []Query, models []Struct, enums []Enum) ([]*plugin.File, error) {
-	t, err := newTemplate(req, templateQueries)
+// renderModelFiles renders the files of the per_model layout: a file per
+// model, the enums in a file of their own and a models file re-exporting them.
+func renderModelFiles(conf Config, req *plugin.GenerateRequest, models []Struct, enums []Enum) ([]*plugin.File, error) {
+	t, err := newTemplate(conf, req, templateModels)
 	if err != nil {
 		return nil, err
 	}
-	// Group queries by their source name
-	sourceQueries := make(map[string][]Query)
-	for _, query := range queries {
-		sourceQueries[query.SourceName] = append(sourceQueries[query.SourceName], query)
-	}
 	var files []*plugin.File
-	for sourceName, queries := range sourceQueries {
-		var queriesFile bytes.Buffer
-		if err = t.Execute(&queriesFile, map[string]any{
+	if len(enums) > 0 {
+		file, err := renderEnums(conf, req, t, enums)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	for _, model := range models {
+		filename := modelFilename(model)
+		// The model is declared at the top of its own file
+		model.Namespace = ""
+		var modelFile bytes.Buffer
+		if err = t.Execute(&modelFile, map[string]any{
 			"Config":       conf,
 			"SQLCVersion":  req.GetSqlcVersion(),
 			"DBImportName": conf.Backend.ImportName(),
-			"Queries":      queries,
-			"Models":       models,
-			"Enums":        enums,
-			"ModelsFile":   modelsFilename,
+			"Models":       []Struct{model},
+			"EnumsFile":    conf.enumsFile(),
+			"EnumRoots":    enumRoots(model.Fields),
+			"ModelsFile":   conf.OutputModelsFileName,
 		}); err != nil {
 			return nil, err
 		}
 		files = append(files, &plugin.File{
-			Name:     fmt.Sprintf("%s.zig", sourceName),
+			Name:     filename,
+			Contents: modelFile.Bytes(),
+		})
+	}
+
+	t, err = newTemplate(conf, req, templateModelsIndex)
+	if err != nil {
+		return nil, err
+	}
+	var indexFile bytes.Buffer
+	if err = t.Execute(&indexFile, map[string]any{
+		"Config":      conf,
+		"SQLCVersion": req.GetSqlcVersion(),
+		"Models":      models,
+		"Enums":       enums,
+		"Namespaces":  buildNamespaces(models, enums),
+		"EnumsFile":   conf.enumsFile(),
+	}); err != nil {
+		return nil, err
+	}
+	return append(files, &plugin.File{
+		Name:     conf.OutputModelsFileName,
+		Contents: indexFile.Bytes(),
+	}), nil
+}
+
+// renderCatalog renders the file combining the query tables of all queries
+// files.
+func renderCatalog(conf Config, req *plugin.GenerateRequest, queryFiles []*plugin.File) (*plugin.File, error) {
+	t, err := newTemplate(conf, req, templateCatalog)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(queryFiles))
+	for _, file := range queryFiles {
+		names = append(names, file.Name)
+	}
+	var catalogFile bytes.Buffer
+	if err = t.Execute(&catalogFile, map[string]any{
+		"SQLCVersion": req.GetSqlcVersion(),
+		"Files":       names,
+	}); err != nil {
+		return nil, err
+	}
+	return &plugin.File{
+		Name:     catalogFilename,
+		Contents: catalogFile.Bytes(),
+	}, nil
+}
+
+// renderSchema renders the file embedding the schema files along with the
+// function applying them.
+func renderSchema(conf Config, req *plugin.GenerateRequest) (*plugin.File, error) {
+	t, err := newTemplate(conf, req, templateSchema)
+	if err != nil {
+		return nil, err
+	}
+	files, err := schemaFiles(conf, req)
+	if err != nil {
+		return nil, err
+	}
+	var schemaFile bytes.Buffer
+	if err = t.Execute(&schemaFile, map[string]any{
+		"SQLCVersion":  req.GetSqlcVersion(),
+		"DBImportName": conf.Backend.ImportName(),
+		"Files":        files,
+	}); err != nil {
+		return nil, err
+	}
+	return &plugin.File{
+		Name:     schemaFilename,
+		Contents: schemaFile.Bytes(),
+	}, nil
+}
+
+// renderRoot renders the root file of the generated module, re-exporting the
+// models and declaring a Querier combining the Queriers of all queries files.
+func renderRoot(conf Config, req *plugin.GenerateRequest, queries []Query, models []Struct, enums []Enum) (*plugin.File, error) {
+	t, err := newTemplate(conf, req, templateRoot)
+	if err != nil {
+		return nil, err
+	}
+	files, err := buildRootFiles(conf, queries, models, enums)
+	if err != nil {
+		return nil, err
+	}
+	var rootFile bytes.Buffer
+	if err = t.Execute(&rootFile, map[string]any{
+		"Config":           conf,
+		"SQLCVersion":      req.GetSqlcVersion(),
+		"DBImportName":     conf.Backend.ImportName(),
+		"ModelsFile":       conf.OutputModelsFileName,
+		"ModelRoots":       modelRoots(models, enums),
+		"Files":            files,
+		"ManagedAllocator": rootManagedAllocator(files),
+	}); err != nil {
+		return nil, err
+	}
+	return &plugin.File{
+		Name:     rootFilename,
+		Contents: rootFile.Bytes(),
+	}, nil
+}
+
+// renderBuildModule renders the build helper registering the generated code as
+// a module, along with the dependencies to declare in build.zig.zon.
+func renderBuildModule(conf Config, req *plugin.GenerateRequest) ([]*plugin.File, error) {
+	version, _ := conf.backendVersion()
+	data := map[string]any{
+		"Config":       conf,
+		"SQLCVersion":  req.GetSqlcVersion(),
+		"DBImportName": conf.Backend.ImportName(),
+		"Dependency":   version.Dependency,
+		"Dir":          outputDir(req.GetSettings().GetCodegen().GetOut()),
+		"RootFile":     rootFilename,
+		"ImportPath":   path.Join(outputDir(req.GetSettings().GetCodegen().GetOut()), buildModuleFilename),
+		"LinkSqlite":   conf.Backend == ZqliteBackend,
+	}
+	var files []*plugin.File
+	for _, file := range []struct {
+		name string
+		tmpl zigTemplate
+	}{
+		{buildModuleFilename, templateBuildModule},
+		{dependenciesFilename, templateDependencies},
+	} {
+		t, err := newTemplate(conf, req, file.tmpl)
+		if err != nil {
+			return nil, err
+		}
+		var contents bytes.Buffer
+		if err = t.Execute(&contents, data); err != nil {
+			return nil, err
+		}
+		files = append(files, &plugin.File{
+			Name:     file.name,
+			Contents: contents.Bytes(),
+		})
+	}
+	return files, nil
+}
+
+// renderFixtures renders the file declaring a fixture per table.
+func renderFixtures(conf Config, req *plugin.GenerateRequest, models []Struct, enums []Enum) (*plugin.File, error) {
+	if err := checkFixtureColumns(models); err != nil {
+		return nil, err
+	}
+	t, err := newTemplate(conf, req, templateFixtures)
+	if err != nil {
+		return nil, err
+	}
+	var fixturesFile bytes.Buffer
+	if err = t.Execute(&fixturesFile, map[string]any{
+		"Config":       conf,
+		"SQLCVersion":  req.GetSqlcVersion(),
+		"DBImportName": conf.Backend.ImportName(),
+		"Models":       models,
+		"Enums":        enums,
+		"Namespaces":   buildNamespaces(models, enums),
+		"ModelsFile":   conf.OutputModelsFileName,
+	}); err != nil {
+		return nil, err
+	}
+	return &plugin.File{
+		Name:     fixturesFilename,
+		Contents: fixturesFile.Bytes(),
+	}, nil
+}
+
+func renderQueries(conf Config, req *plugin.GenerateRequest, queries []Query, models []Struct, enums []Enum) ([]*plugin.File, error) {
+	t, err := newTemplate(conf, req, templateQueries)
+	if err != nil {
+		return nil, err
+	}
+	sourceNames, sourceQueries := groupQueries(queries)
+	var files []*plugin.File
+	for _, sourceName := range sourceNames {
+		queries := sourceQueries[sourceName]
+		var modelImports *ModelImports
+		if conf.ModelsLayout == ModelsLayoutPerModel {
+			modelImports = buildModelImports(conf, queries, enums)
+		}
+		var queriesFile bytes.Buffer
+		if err = t.Execute(&queriesFile, map[string]any{
+			"Config":           conf,
+			"SQLCVersion":      req.GetSqlcVersion(),
+			"DBImportName":     conf.Backend.ImportName(),
+			"Queries":          queries,
+			"Models":           models,
+			"Enums":            enums,
+			"ModelsFile":       conf.OutputModelsFileName,
+			"ModelImports":     modelImports,
+			"CatalogFile":      catalogFilename,
+			"ManagedAllocator": querierAllocator(conf, queries),
+			"Timeouts":         hasTimeouts(queries),
+		}); err != nil {
+			return nil, err
+		}
+		files = append(files, &plugin.File{
+			Name:     sourceName + conf.OutputFilesSuffix,
 			Contents: queriesFile.Bytes(),
 		})
 	}
 	return files, nil
 }
 
+// groupQueries groups the queries by the name of the file they were read from,
+// returning the sorted file names along with the groups.
+func groupQueries(queries []Query) ([]string, map[string][]Query) {
+	sourceQueries := make(map[string][]Query)
+	var sourceNames []string
+	for _, query := range queries {
+		if _, ok := sourceQueries[query.SourceName]; !ok {
+			sourceNames = append(sourceNames, query.SourceName)
+		}
+		sourceQueries[query.SourceName] = append(sourceQueries[query.SourceName], query)
+	}
+	sort.Strings(sourceNames)
+	return sourceNames, sourceQueries
+}
+
+// querierAllocator reports whether a Querier needs to hold an allocator, which
+// is the case when the package wide configuration or any of its queries use
+// managed allocations.
+func querierAllocator(conf Config, queries []Query) bool {
+	if !conf.UnmanagedAllocations && !conf.UseContext {
+		return true
+	}
+	for _, query := range queries {
+		if !query.Config.UnmanagedAllocations && !query.Config.UseContext {
+			return true
+		}
+	}
+	return false
+}
+
+// hasTimeouts reports whether any of the queries has a timeout, in which case
+// the helpers for detecting timeouts are declared.
+func hasTimeouts(queries []Query) bool {
+	for _, query := range queries {
+		if query.Config.QueryTimeoutMs > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func getConfig(req *plugin.GenerateRequest) (conf Config, err error) {
 	conf.Default(req)
 	if len(req.PluginOptions) > 0 {
-		if err := json.Unmarshal(req.PluginOptions, &conf); err != nil {
-			return conf, err
-		}
+		err = conf.Decode(req.PluginOptions)
+	}
+	if verr := conf.Validate(req); verr != nil {
+		err = errors.Join(err, verr)
+	}
+	if aerr := validateAnnotations(conf, req); aerr != nil {
+		err = errors.Join(err, aerr)
 	}
-	return conf, conf.Validate(req)
+	if err != nil {
+		return conf, fmt.Errorf("invalid plugin options:\n%w", err)
+	}
+	return conf, nil
 }
 
 type zigTemplate string
 
 const (
-	templateModels  zigTemplate = "models"
-	templateQueries zigTemplate = "queries"
+	templateModels       zigTemplate = "models"
+	templateQueries      zigTemplate = "queries"
+	templateCatalog      zigTemplate = "catalog"
+	templateSchema       zigTemplate = "schema"
+	templateFixtures     zigTemplate = "fixtures"
+	templateRoot         zigTemplate = "root"
+	templateBuildModule  zigTemplate = "build_module"
+	templateDependencies zigTemplate = "dependencies"
+	// The models file re-exporting the models of the per_model layout
+	templateModelsIndex zigTemplate = "models_index"
 )
 
-func newTemplate(req *plugin.GenerateRequest, tmpl zigTemplate) (*template.Template, error) {
-	t := template.New(fmt.Sprintf("%s.zig.gotmpl", tmpl))
+func newTemplate(conf Config, req *plugin.GenerateRequest, tmpl zigTemplate) (*template.Template, error) {
+	t := template.New(tmpl.filename())
 	t, err := t.Funcs(commonTemplateFuncs(t)).Funcs(engineTemplateFuncs(t, req)).
 		ParseFS(templates, templatePaths(req, tmpl)...)
 	if err != nil {
 		return nil, err
 	}
+	if err := applyTemplateOverrides(t, conf.TemplateOverrides); err != nil {
+		return nil, err
+	}
 	return t, nil
 }
 
+// applyTemplateOverrides parses the templates given with template_overrides
+// into t, replacing the embedded templates of the same name.
+func applyTemplateOverrides(t *template.Template, overrides map[string]string) error {
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if name == "" {
+			return fmt.Errorf("template_overrides: template names must not be empty")
+		}
+		if _, err := t.New(name).Parse(overrides[name]); err != nil {
+			return fmt.Errorf("template_overrides: %w", err)
+		}
+	}
+	return nil
+}
+
+// filename returns the name of the template file, which is named after the
+// file it renders.
+func (t zigTemplate) filename() string {
+	if t == templateDependencies {
+		return fmt.Sprintf("%s.zon.gotmpl", t)
+	}
+	return fmt.Sprintf("%s.zig.gotmpl", t)
+}
+
 func templatePaths(req *plugin.GenerateRequest, tmpl zigTemplate) []string {
+	switch tmpl {
+	case templateCatalog, templateModelsIndex, templateBuildModule, templateDependencies:
+		// These files do not depend on the engine
+		return []string{"templates/common/*.gotmpl", "templates/" + tmpl.filename()}
+	}
 	engine := req.GetSettings().GetEngine()
-	return []string{
+	paths := []string{
+		"templates/common/*.gotmpl",
 		fmt.Sprintf("templates/%s/helpers.gotmpl", engine),
-		fmt.Sprintf("templates/%s/%s.zig.gotmpl", engine, tmpl),
 	}
+	if engine == enginePostgres && tmpl == templateQueries {
+		paths = append(paths, "templates/postgresql/routed.gotmpl")
+	}
+	return append(paths, fmt.Sprintf("templates/%s/%s", engine, tmpl.filename()))
 }
@@ -0,0 +1,179 @@
+package zig
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// TestGenerate runs the generator against the requests recorded in
+// testdata/<engine>/request.json (see `make fixtures`). Each directory in
+// testdata/<engine>/golden holds an options.json with the plugin options to
+// use, along with the expected output files. Requests that are expected to
+// fail have their error message stored in error.txt instead.
+func TestGenerate(t *testing.T) {
+	for _, engine := range []string{enginePostgres, engineSqlite} {
+		t.Run(engine, func(t *testing.T) {
+			req := loadRequest(t, filepath.Join("testdata", engine, "request.json"))
+			cases, err := os.ReadDir(filepath.Join("testdata", engine, "golden"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, c := range cases {
+				if !c.IsDir() {
+					continue
+				}
+				dir := filepath.Join("testdata", engine, "golden", c.Name())
+				t.Run(c.Name(), func(t *testing.T) {
+					testGenerate(t, req, dir)
+				})
+			}
+		})
+	}
+}
+
+func testGenerate(t *testing.T, req *plugin.GenerateRequest, dir string) {
+	options, err := os.ReadFile(filepath.Join(dir, "options.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req = proto.Clone(req).(*plugin.GenerateRequest)
+	req.PluginOptions = options
+
+	got := make(map[string][]byte)
+	resp, err := Generate(context.Background(), req)
+	if err != nil {
+		got["error.txt"] = []byte(err.Error() + "\n")
+	} else {
+		for _, file := range resp.GetFiles() {
+			got[file.GetName()] = file.GetContents()
+		}
+	}
+
+	if *update {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, entry := range entries {
+			if entry.Name() != "options.json" {
+				if err := os.Remove(filepath.Join(dir, entry.Name())); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+		for name, contents := range got {
+			if err := os.WriteFile(filepath.Join(dir, name), contents, 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := make(map[string][]byte)
+	for _, entry := range entries {
+		if entry.Name() == "options.json" {
+			continue
+		}
+		contents, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want[entry.Name()] = contents
+	}
+	for name, contents := range want {
+		if _, ok := got[name]; !ok {
+			t.Errorf("%s was not generated", name)
+			continue
+		}
+		if line, ok := firstDiff(string(contents), string(got[name])); !ok {
+			t.Errorf("%s does not match the golden file (run `go test ./internal -update` to update it): %s", name, line)
+		}
+	}
+	for name := range got {
+		if _, ok := want[name]; !ok {
+			t.Errorf("unexpected file %s was generated", name)
+		}
+	}
+}
+
+func loadRequest(t *testing.T, path string) *plugin.GenerateRequest {
+	t.Helper()
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var req plugin.GenerateRequest
+	if err := protojson.Unmarshal(contents, &req); err != nil {
+		t.Fatal(err)
+	}
+	return &req
+}
+
+// firstDiff describes the first line that differs between want and got.
+func firstDiff(want, got string) (string, bool) {
+	if want == got {
+		return "", true
+	}
+	wantLines, gotLines := strings.Split(want, "\n"), strings.Split(got, "\n")
+	for i := 0; i < max(len(wantLines), len(gotLines)); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			return fmt.Sprintf("line %d:\n\twant: %q\n\tgot:  %q", i+1, w, g), false
+		}
+	}
+	return "", false
+}
+
+func TestGenerateFileOrder(t *testing.T) {
+	for _, engine := range []string{enginePostgres, engineSqlite} {
+		t.Run(engine, func(t *testing.T) {
+			req := loadRequest(t, filepath.Join("testdata", engine, "request.json"))
+			var first []*plugin.File
+			for i := 0; i < 10; i++ {
+				resp, err := Generate(context.Background(), req)
+				if err != nil {
+					t.Fatal(err)
+				}
+				files := resp.GetFiles()
+				for j := 1; j < len(files); j++ {
+					if files[j-1].GetName() >= files[j].GetName() {
+						t.Fatalf("files are not sorted by name: %s before %s", files[j-1].GetName(), files[j].GetName())
+					}
+				}
+				if first == nil {
+					first = files
+					continue
+				}
+				if len(files) != len(first) {
+					t.Fatalf("got %d files, previously %d", len(files), len(first))
+				}
+				for j, file := range files {
+					if file.GetName() != first[j].GetName() || string(file.GetContents()) != string(first[j].GetContents()) {
+						t.Fatalf("output changed between runs at %s", file.GetName())
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package zig
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// enumsFilename is the default name of the enums file of the per_model
+// layout.
+const enumsFilename = "enums.zig"
+
+// modelFilename returns the name of the file declaring a model with the
+// per_model layout, e.g. "user.model.zig" or "billing.invoice.model.zig".
+func modelFilename(model Struct) string {
+	name := snakeCase(model.StructName) + ".model.zig"
+	if model.Namespace != "" {
+		return model.Namespace + "." + name
+	}
+	return name
+}
+
+// enumRoots returns the top level names of the enums used by the fields,
+// which are either the enum itself or the namespace it is declared in.
+func enumRoots(fields []Field) []string {
+	seen := make(map[string]bool)
+	var roots []string
+	for _, field := range fields {
+		if !field.Enum {
+			continue
+		}
+		root, _, _ := strings.Cut(field.ZigType, ".")
+		if !seen[root] {
+			seen[root] = true
+			roots = append(roots, root)
+		}
+	}
+	sort.Strings(roots)
+	return roots
+}
+
+// ModelImport declares a model or enum of a queries file, imported from the
+// file declaring it.
+type ModelImport struct {
+	Name string
+	Expr string
+}
+
+// ModelImports are the models and enums used by a queries file, grouped by
+// the namespace they are declared in.
+type ModelImports struct {
+	Imports    []ModelImport
+	Namespaces []ModelImportNamespace
+}
+
+type ModelImportNamespace struct {
+	Name    string
+	Imports []ModelImport
+}
+
+func (m *ModelImports) Empty() bool {
+	return len(m.Imports) == 0 && len(m.Namespaces) == 0
+}
+
+// buildModelImports collects the models and enums used by the queries of a
+// single file for the per_model layout.
+func buildModelImports(conf Config, queries []Query, enums []Enum) *ModelImports {
+	byName := make(map[string]ModelImport)
+	namespaces := make(map[string]string)
+	add := func(namespace, name, expr string) {
+		qualified := qualifiedName(namespace, name)
+		byName[qualified] = ModelImport{Name: name, Expr: expr}
+		namespaces[qualified] = namespace
+	}
+	enumsByName := make(map[string]Enum, len(enums))
+	for _, enum := range enums {
+		enumsByName[enum.QualifiedName()] = enum
+	}
+	addValue := func(value *QueryValue) {
+		if value == nil {
+			return
+		}
+		fields := []Field{}
+		if value.Struct != nil {
+			if !value.Emit {
+				add(value.Struct.Namespace, value.Struct.StructName,
+					fmt.Sprintf("@import(\"%s\").%s", modelFilename(*value.Struct), value.Struct.StructName))
+			}
+			fields = value.Struct.Fields
+		} else if value.Field != nil {
+			fields = []Field{*value.Field}
+		}
+		for _, field := range fields {
+			enum, ok := enumsByName[field.ZigType]
+			if !field.Enum || !ok {
+				continue
+			}
+			add(enum.Namespace, enum.ZigName,
+				fmt.Sprintf("@import(\"%s\").%s", conf.enumsFile(), enum.QualifiedName()))
+		}
+	}
+	for _, query := range queries {
+		addValue(query.Ret)
+		for i := range query.Args {
+			addValue(&query.Args[i])
+		}
+	}
+
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	imports := &ModelImports{}
+	nsIndex := make(map[string]int)
+	for _, name := range names {
+		imp := byName[name]
+		ns := namespaces[name]
+		if ns == "" {
+			imports.Imports = append(imports.Imports, imp)
+			continue
+		}
+		idx, ok := nsIndex[ns]
+		if !ok {
+			idx = len(imports.Namespaces)
+			nsIndex[ns] = idx
+			imports.Namespaces = append(imports.Namespaces, ModelImportNamespace{Name: ns})
+		}
+		imports.Namespaces[idx].Imports = append(imports.Namespaces[idx].Imports, imp)
+	}
+	return imports
+}
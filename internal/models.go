@@ -13,25 +13,47 @@ type Struct struct {
 	ID         Identifier
 	TableName  string
 	StructName string
+	Namespace  string
 	Comment    string
 	Fields     []Field
 }
 
+// QualifiedName returns the name of the struct relative to the models file,
+// including the namespace it is declared in (if any).
+func (s Struct) QualifiedName() string {
+	return qualifiedName(s.Namespace, s.StructName)
+}
+
 type Identifier struct {
 	Schema string
 	Name   string
 }
 
-func buildModels(conf Config, req *plugin.GenerateRequest) []Struct {
+// Namespace groups the models and enums declared under a single non-default
+// schema when emit_schema_namespaces is enabled.
+type Namespace struct {
+	Name   string
+	Models []Struct
+	Enums  []Enum
+}
+
+func buildModels(conf Config, req *plugin.GenerateRequest) ([]Struct, error) {
 	var structs []Struct
+	names := newIdentifierSet("tables")
 	for _, schema := range req.GetCatalog().GetSchemas() {
 		if isInternalSchema(schema.GetName()) {
 			continue
 		}
 		for _, table := range schema.GetTables() {
-			var tableName string
+			var tableName, namespace string
 			if schema.GetName() == req.GetCatalog().GetDefaultSchema() {
 				tableName = table.GetRel().GetName()
+			} else if conf.EmitSchemaNamespaces {
+				if err := validateNamespace(schema.GetName()); err != nil {
+					return nil, err
+				}
+				tableName = table.GetRel().GetName()
+				namespace = schema.GetName()
 			} else {
 				tableName = fmt.Sprintf("%s_%s", schema.GetName(), table.GetRel().GetName())
 			}
@@ -42,15 +64,95 @@ func buildModels(conf Config, req *plugin.GenerateRequest) []Struct {
 					Exclusions: conf.InflectionExcludeTableNames,
 				})
 			}
-			structs = append(structs, Struct{
+			key := tableKey(req.GetCatalog(), &plugin.Identifier{Schema: schema.GetName(), Name: table.GetRel().GetName()})
+			fields, err := buildFields(conf, req, table.GetColumns())
+			if err != nil {
+				return nil, fmt.Errorf("table %s: %w", key, err)
+			}
+			st := Struct{
 				ID:         Identifier{Schema: schema.GetName(), Name: table.GetRel().GetName()},
 				TableName:  tableName,
-				StructName: modelName(structName),
+				StructName: conf.renamed(modelName(structName), tableRenameKey(key)),
+				Namespace:  namespace,
 				Comment:    table.Comment,
-				Fields:     buildFields(conf, req, table.GetColumns()),
-			})
+				Fields:     fields,
+			}
+			if err := names.add(key, st.QualifiedName()); err != nil {
+				return nil, err
+			}
+			structs = append(structs, st)
+		}
+	}
+	sort.SliceStable(structs, func(i, j int) bool { return structs[i].QualifiedName() < structs[j].QualifiedName() })
+	return structs, nil
+}
+
+// checkModelsFileNames rejects models, enums and namespaces that share a
+// name, since all of them are declared at the top level of the models file.
+func checkModelsFileNames(models []Struct, enums []Enum) error {
+	names := newIdentifierSet("models file declarations")
+	for _, name := range modelsFileDeclarations {
+		if err := names.add("generated "+name, name); err != nil {
+			return err
+		}
+	}
+	add := func(sqlName, namespace, zigName string) error {
+		if namespace != "" {
+			if err := names.add("schema "+namespace, namespace); err != nil {
+				return err
+			}
 		}
+		return names.add(sqlName, qualifiedName(namespace, zigName))
+	}
+	for _, model := range models {
+		if err := add(fmt.Sprintf("table %s.%s", model.ID.Schema, model.ID.Name), model.Namespace, model.StructName); err != nil {
+			return err
+		}
+	}
+	for _, enum := range enums {
+		if err := add("enum "+enum.Name, enum.Namespace, enum.ZigName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildNamespaces groups the models and enums that were assigned a namespace.
+// Models and enums in the default schema are not included.
+func buildNamespaces(models []Struct, enums []Enum) []Namespace {
+	byName := make(map[string]*Namespace)
+	var names []string
+	lookup := func(name string) *Namespace {
+		if ns, ok := byName[name]; ok {
+			return ns
+		}
+		byName[name] = &Namespace{Name: name}
+		names = append(names, name)
+		return byName[name]
+	}
+	for _, model := range models {
+		if model.Namespace != "" {
+			ns := lookup(model.Namespace)
+			ns.Models = append(ns.Models, model)
+		}
+	}
+	for _, enum := range enums {
+		if enum.Namespace != "" {
+			ns := lookup(enum.Namespace)
+			ns.Enums = append(ns.Enums, enum)
+		}
+	}
+	sort.Strings(names)
+	namespaces := make([]Namespace, 0, len(names))
+	for _, name := range names {
+		namespaces = append(namespaces, *byName[name])
+	}
+	return namespaces
+}
+
+func qualifiedName(namespace, name string) string {
+	if namespace == "" {
+		return name
 	}
-	sort.Slice(structs, func(i, j int) bool { return structs[i].StructName < structs[j].StructName })
-	return structs
+	return fmt.Sprintf("%s.%s", namespace, name)
 }
@@ -3,7 +3,9 @@ package zig
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"sort"
+	"strings"
 
 	"github.com/sqlc-dev/plugin-sdk-go/metadata"
 	"github.com/sqlc-dev/plugin-sdk-go/plugin"
@@ -14,12 +16,20 @@ type Query struct {
 	Cmd          string
 	Comments     []string
 	MethodName   string
+	SQLCName     string
+	UnhookedName string
+	UntimedName  string
+	FreeName     string
+	Route        Route
 	FieldName    string
 	ConstantName string
 	SQL          string
 	SourceName   string
 	Ret          *QueryValue
 	Args         []QueryValue
+	// The configuration for this query, with any annotations from the
+	// query comments applied
+	Config Config
 }
 
 func (q *Query) ArgNames() []string {
@@ -44,6 +54,57 @@ func (q *Query) ArgNames() []string {
 	return names
 }
 
+// OwnsRowMemory reports whether the rows returned by a :many query hold
+// allocated memory besides the slice itself.
+func (q *Query) OwnsRowMemory() bool {
+	if q.Cmd != metadata.CmdMany || q.Config.UseContext || q.Ret == nil {
+		return false
+	}
+	if q.Ret.Field != nil {
+		return q.Ret.Field.Array || isNonScalarBaseType(*q.Ret.Field)
+	}
+	return hasNonScalarFields(*q.Ret.Struct)
+}
+
+// ParamFields returns the parameters of a query in the order they are bound,
+// named as in the generated method or parameter struct.
+func (q *Query) ParamFields() []Field {
+	var fields []Field
+	for i, name := range q.ArgNames() {
+		arg := q.Args[i]
+		if arg.Struct != nil {
+			fields = append(fields, arg.Struct.Fields...)
+			continue
+		}
+		field := *arg.Field
+		field.Name = name
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// ResultColumns returns the names of the columns returned by a query.
+func (q *Query) ResultColumns() []string {
+	if q.Ret == nil {
+		return nil
+	}
+	fields := []Field{}
+	if q.Ret.Struct != nil {
+		fields = q.Ret.Struct.Fields
+	} else {
+		fields = append(fields, *q.Ret.Field)
+	}
+	columns := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if field.Column == "" {
+			columns = append(columns, field.Name)
+		} else {
+			columns = append(columns, field.Column)
+		}
+	}
+	return columns
+}
+
 func (q *Query) RequiresAllocations() bool {
 	if q.Cmd == metadata.CmdExec || q.Ret == nil {
 		return false
@@ -66,6 +127,7 @@ type QueryValue struct {
 
 func buildQueries(conf Config, req *plugin.GenerateRequest, structs []Struct) ([]Query, error) {
 	queries := make([]Query, 0, len(req.Queries))
+	methods := make(map[string]*identifierSet)
 	for _, query := range req.Queries {
 		if query.GetName() == "" {
 			continue
@@ -77,35 +139,79 @@ func buildQueries(conf Config, req *plugin.GenerateRequest, structs []Struct) ([
 			return nil, errors.New("Support for CopyFrom in Zig is not implemented")
 		}
 
+		qconf, comments, err := parseAnnotations(conf, query.GetComments())
+		if err != nil {
+			return nil, fmt.Errorf("query %s: %w", query.GetName(), err)
+		}
+		if qconf.PGErrorUnions && req.GetSettings().GetEngine() != enginePostgres {
+			return nil, fmt.Errorf("query %s: pg_error_unions is not supported for %s", query.GetName(), req.GetSettings().GetEngine())
+		}
+
 		gq := Query{
 			Cmd:        query.GetCmd(),
-			Comments:   query.GetComments(),
-			MethodName: camelCase(query.GetName()),
+			Comments:   comments,
+			MethodName: conf.MethodCase.Apply(query.GetName()),
 			// FieldName:    sdk.LowerTitle(query.GetName()) + "Stmt",
 			ConstantName: snakeCase(query.GetName() + "Sql"),
 			SQL:          query.GetText(),
 			SourceName:   query.GetFilename(),
+			SQLCName:     query.GetName(),
+			Route:        queryRoute(qconf, query),
+			Config:       qconf,
+		}
+		if methods[gq.SourceName] == nil {
+			methods[gq.SourceName] = newIdentifierSet("queries")
+		}
+		if err := methods[gq.SourceName].add(query.GetName(), gq.MethodName); err != nil {
+			return nil, fmt.Errorf("%s: %w", gq.SourceName, err)
+		}
+		if conf.EmitHooks {
+			// The query itself is implemented by a private method, wrapped by
+			// the public one calling the hooks
+			gq.UnhookedName = conf.MethodCase.Apply(query.GetName() + "Unhooked")
+			if err := methods[gq.SourceName].add(query.GetName()+"Unhooked", gq.UnhookedName); err != nil {
+				return nil, fmt.Errorf("%s: %w", gq.SourceName, err)
+			}
+		}
+		if qconf.QueryTimeoutMs > 0 && req.GetSettings().GetEngine() == enginePostgres {
+			// The query runs in a private method on a connection whose
+			// statement_timeout is set and restored by the method wrapping it
+			gq.UntimedName = conf.MethodCase.Apply(query.GetName() + "Untimed")
+			if err := methods[gq.SourceName].add(query.GetName()+"Untimed", gq.UntimedName); err != nil {
+				return nil, fmt.Errorf("%s: %w", gq.SourceName, err)
+			}
 		}
 
 		// Parse query parameters
-		if len(query.GetParams()) <= conf.QueryParameterLimit {
+		if len(query.GetParams()) <= qconf.QueryParameterLimit {
 			// Inline the parameters
+			names := newIdentifierSet("parameters")
 			for _, param := range query.GetParams() {
-				zigType, isEnum := zigDataType(req, param.GetColumn())
+				name := paramName(conf, req, param)
+				if err := names.add(param.GetColumn().GetName(), name); err != nil {
+					return nil, fmt.Errorf("query %s: %w", query.GetName(), err)
+				}
+				zigType, isEnum := zigDataType(conf, req, param.GetColumn())
 				gq.Args = append(gq.Args, QueryValue{
-					Name: paramName(param),
+					Name: name,
 					Field: &Field{
-						Name:     paramName(param),
-						Array:    param.GetColumn().IsArray,
-						Nullable: !param.GetColumn().NotNull,
-						ZigType:  zigType,
-						Enum:     isEnum,
+						Name:       name,
+						Array:      param.GetColumn().IsArray,
+						Nullable:   !param.GetColumn().NotNull,
+						ZigType:    zigType,
+						Enum:       isEnum,
+						EnumMapped: isEnum && enumMapped(conf, req.GetCatalog(), dbDataType(param.GetColumn().GetType())),
+						Column:     param.GetColumn().GetName(),
+						SQLType:    sqlTypeName(param.GetColumn().GetType()),
 					},
 				})
 			}
 		} else {
 			// Create a struct for the parameters
-			st := paramsToStruct(conf, req, query, query.GetParams())
+			st, err := paramsToStruct(conf, req, query, query.GetParams())
+			if err != nil {
+				return nil, fmt.Errorf("query %s: %w", query.GetName(), err)
+			}
 			gq.Args = []QueryValue{{
 				Name:   snakeCase(st.StructName),
 				Struct: st,
@@ -113,25 +219,36 @@ func buildQueries(conf Config, req *plugin.GenerateRequest, structs []Struct) ([
 			}}
 		}
 
+		if err := checkMappedEnumArrayArgs(gq); err != nil {
+			return nil, fmt.Errorf("query %s: %w", query.GetName(), err)
+		}
+
 		// Parse query return values
 		if len(query.GetColumns()) > 0 {
 			if len(query.GetColumns()) == 1 {
 				col := query.GetColumns()[0]
-				zigType, isEnum := zigDataType(req, col)
+				zigType, isEnum := zigDataType(conf, req, col)
 				gq.Ret = &QueryValue{
-					Name: columnName(col, 0),
+					Name: columnName(conf, req, col, 0),
 					Field: &Field{
-						Name:     columnName(col, 0),
-						Array:    col.IsArray,
-						Nullable: !col.NotNull,
-						ZigType:  zigType,
-						Enum:     isEnum,
+						Name:       columnName(conf, req, col, 0),
+						Array:      col.IsArray,
+						Nullable:   !col.NotNull,
+						ZigType:    zigType,
+						Enum:       isEnum,
+						EnumMapped: isEnum && enumMapped(conf, req.GetCatalog(), dbDataType(col.GetType())),
+						Column:     col.GetName(),
+						SQLType:    sqlTypeName(col.GetType()),
 					},
 				}
 			} else {
 				var st *Struct
 				var emit bool
 				for _, s := range structs {
+					// Models are laid out for the package wide use_context setting
+					if qconf.UseContext != conf.UseContext {
+						break
+					}
 					if len(s.Fields) != len(query.Columns) {
 						continue
 					}
@@ -139,8 +256,8 @@ func buildQueries(conf Config, req *plugin.GenerateRequest, structs []Struct) ([
 
 					for i, f := range s.Fields {
 						c := query.GetColumns()[i]
-						zigType, _ := zigDataType(req, c)
-						sameName := f.Name == columnName(c, i)
+						zigType, _ := zigDataType(conf, req, c)
+						sameName := f.Name == columnName(conf, req, c, i)
 						sameType := f.ZigType == zigType
 						sameTable := sdk.SameTableName(c.Table, &plugin.Identifier{Name: s.ID.Name, Schema: s.ID.Schema}, req.Catalog.DefaultSchema)
 						if !sameName || !sameType || !sameTable {
@@ -153,7 +270,11 @@ func buildQueries(conf Config, req *plugin.GenerateRequest, structs []Struct) ([
 					}
 				}
 				if st == nil {
-					st = columnsToStruct(conf, req, query, query.GetColumns())
+					var err error
+					st, err = columnsToStruct(conf, req, query, query.GetColumns())
+					if err != nil {
+						return nil, fmt.Errorf("query %s: %w", query.GetName(), err)
+					}
 					emit = true
 				}
 				gq.Ret = &QueryValue{
@@ -163,25 +284,56 @@ func buildQueries(conf Config, req *plugin.GenerateRequest, structs []Struct) ([
 			}
 		}
 
+		if gq.OwnsRowMemory() {
+			gq.FreeName = conf.MethodCase.Apply("Free" + query.GetName())
+			if err := methods[gq.SourceName].add("Free"+query.GetName(), gq.FreeName); err != nil {
+				return nil, fmt.Errorf("%s: %w", gq.SourceName, err)
+			}
+		}
+
 		queries = append(queries, gq)
 	}
-	sort.Slice(queries, func(i, j int) bool { return queries[i].MethodName < queries[j].MethodName })
+	sort.SliceStable(queries, func(i, j int) bool { return queries[i].MethodName < queries[j].MethodName })
 	return queries, nil
 }
 
-func paramsToStruct(conf Config, req *plugin.GenerateRequest, query *plugin.Query, params []*plugin.Parameter) *Struct {
+// checkMappedEnumArrayArgs rejects enum array parameters whose values were
+// renamed. They would be bound using the Zig tag names instead of the database
+// labels.
+func checkMappedEnumArrayArgs(q Query) error {
+	for _, arg := range q.Args {
+		fields := []Field{}
+		if arg.Struct != nil {
+			fields = arg.Struct.Fields
+		} else {
+			fields = append(fields, *arg.Field)
+		}
+		for _, field := range fields {
+			if field.EnumMapped && field.Array {
+				return fmt.Errorf("parameter %s: arrays of enums with renamed values are not supported", field.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func paramsToStruct(conf Config, req *plugin.GenerateRequest, query *plugin.Query, params []*plugin.Parameter) (*Struct, error) {
 	structName := fmt.Sprintf("%sParams", pascalCase(query.GetName()))
+	fields, err := buildFields(conf, req, func() []*plugin.Column {
+		var columns []*plugin.Column
+		for _, param := range params {
+			columns = append(columns, param.GetColumn())
+		}
+		return columns
+	}())
+	if err != nil {
+		return nil, err
+	}
 	gs := Struct{
 		TableName:  structName,
 		StructName: structName,
 		Comment:    fmt.Sprintf("Parameters for %s", query.GetName()),
-		Fields: buildFields(conf, req, func() []*plugin.Column {
-			var columns []*plugin.Column
-			for _, param := range params {
-				columns = append(columns, param.GetColumn())
-			}
-			return columns
-		}()),
+		Fields:     fields,
 	}
 	// Force CIDR/INET fields and Numerics to native types
 	for i, field := range gs.Fields {
@@ -192,30 +344,66 @@ func paramsToStruct(conf Config, req *plugin.GenerateRequest, query *plugin.Quer
 			gs.Fields[i].ZigType = "f64"
 		}
 	}
-	return &gs
+	return &gs, nil
 }
 
-func columnsToStruct(conf Config, req *plugin.GenerateRequest, query *plugin.Query, columns []*plugin.Column) *Struct {
+func columnsToStruct(conf Config, req *plugin.GenerateRequest, query *plugin.Query, columns []*plugin.Column) (*Struct, error) {
 	structName := fmt.Sprintf("%sRow", pascalCase(query.GetName()))
+	fields, err := buildFields(conf, req, columns)
+	if err != nil {
+		return nil, err
+	}
 	gs := Struct{
 		TableName:  structName,
 		StructName: structName,
 		Comment:    fmt.Sprintf("Result for %s", query.GetName()),
-		Fields:     buildFields(conf, req, columns),
+		Fields:     fields,
 	}
-	return &gs
+	return &gs, nil
 }
 
-func paramName(param *plugin.Parameter) string {
+func paramName(conf Config, req *plugin.GenerateRequest, param *plugin.Parameter) string {
 	if param.GetColumn().GetName() != "" {
-		return snakeCase(param.GetColumn().GetName())
+		return conf.renamed(snakeCase(param.GetColumn().GetName()), columnRenameKeys(req.GetCatalog(), param.GetColumn())...)
 	}
 	return fmt.Sprintf("param_%d", param.GetNumber())
 }
 
-func columnName(c *plugin.Column, pos int) string {
+func columnName(conf Config, req *plugin.GenerateRequest, c *plugin.Column, pos int) string {
 	if c.Name != "" {
-		return c.Name
+		return fieldName(conf, req, c, c.Name)
 	}
 	return fmt.Sprintf("column_%d", pos+1)
 }
+
+// lockingClause matches the row locking clauses of a SELECT statement, which
+// can only run on the primary.
+var lockingClause = regexp.MustCompile(`(?i)\bfor\s+(no\s+key\s+update|update|key\s+share|share)\b`)
+
+// sideEffectFunctions matches calls of the builtin functions that write or
+// take locks, e.g. `SELECT nextval('seq')`.
+var sideEffectFunctions = regexp.MustCompile(`(?i)\b(nextval|setval|set_config|pg_notify|pg_(try_)?advisory_(xact_)?lock(_shared)?|lo_[a-z_]+)\s*\(`)
+
+// queryRoute returns the pool a RoutedQuerier sends a query to. Queries
+// returning rows from a plain SELECT statement go to the replica, anything
+// else may write and goes to the primary, unless overridden with the route
+// annotation. SELECT statements locking rows or calling builtin functions with
+// side effects go to the primary as well, other volatile functions need the
+// annotation.
+func queryRoute(conf Config, query *plugin.Query) Route {
+	if conf.Route != "" {
+		return conf.Route
+	}
+	if query.GetCmd() != metadata.CmdOne && query.GetCmd() != metadata.CmdMany {
+		return RoutePrimary
+	}
+	text := query.GetText()
+	words := strings.Fields(text)
+	if len(words) == 0 || !strings.EqualFold(words[0], "select") {
+		return RoutePrimary
+	}
+	if lockingClause.MatchString(text) || sideEffectFunctions.MatchString(text) {
+		return RoutePrimary
+	}
+	return RouteReplica
+}
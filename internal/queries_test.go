@@ -0,0 +1,43 @@
+package zig
+
+import (
+	"testing"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+func TestQueryRoute(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		cmd      string
+		text     string
+		comments []string
+		want     Route
+	}{
+		{"select", ":many", "SELECT id FROM users", nil, RouteReplica},
+		{"lowercase select", ":one", "select id from users where id = $1", nil, RouteReplica},
+		{"exec", ":exec", "SELECT pg_sleep(1)", nil, RoutePrimary},
+		{"insert returning", ":one", "INSERT INTO users (name) VALUES ($1) RETURNING id", nil, RoutePrimary},
+		{"cte", ":many", "WITH deleted AS (DELETE FROM users RETURNING id) SELECT id FROM deleted", nil, RoutePrimary},
+		{"for update", ":one", "SELECT id FROM users WHERE id = $1 FOR UPDATE", nil, RoutePrimary},
+		{"for no key update", ":one", "SELECT id FROM users WHERE id = $1\nFOR NO KEY UPDATE SKIP LOCKED", nil, RoutePrimary},
+		{"for share", ":many", "SELECT id FROM users for share", nil, RoutePrimary},
+		{"for key share", ":many", "SELECT id FROM users FOR KEY SHARE OF users", nil, RoutePrimary},
+		{"nextval", ":one", "SELECT nextval('users_id_seq')", nil, RoutePrimary},
+		{"advisory lock", ":one", "SELECT pg_try_advisory_lock($1)", nil, RoutePrimary},
+		{"column named for", ":many", "SELECT for_update FROM flags", nil, RouteReplica},
+		{"route primary", ":many", "SELECT id FROM users", []string{"@zig route=primary"}, RoutePrimary},
+		{"route replica", ":one", "SELECT id FROM users FOR UPDATE", []string{"@zig route=replica"}, RouteReplica},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			conf, _, err := parseAnnotations(Config{}, tc.comments)
+			if err != nil {
+				t.Fatal(err)
+			}
+			query := &plugin.Query{Name: "Query", Cmd: tc.cmd, Text: tc.text}
+			if got := queryRoute(conf, query); got != tc.want {
+				t.Errorf("queryRoute() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package zig
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+// Rename keys of tables, enum types and enum values are prefixed with their
+// kind, so that they never match the keys of columns, which are given as
+// "table.column" or "column".
+const (
+	renameTablePrefix = "table:"
+	renameEnumPrefix  = "enum:"
+	renameValuePrefix = "value:"
+)
+
+func tableRenameKey(key string) string {
+	return renameTablePrefix + key
+}
+
+func enumRenameKey(key string) string {
+	return renameEnumPrefix + key
+}
+
+func enumValueRenameKey(enumKey, value string) string {
+	return fmt.Sprintf("%s%s.%s", renameValuePrefix, enumKey, value)
+}
+
+// validateRename checks that a rename key has a known kind and that the
+// name it maps to is a valid Zig identifier for that kind. Enum values are
+// declared as quoted identifiers and may be keywords.
+func validateRename(from, to string) error {
+	kind, _, ok := strings.Cut(from, ":")
+	if !ok {
+		kind = "column"
+	} else if kind+":" != renameTablePrefix && kind+":" != renameEnumPrefix && kind+":" != renameValuePrefix {
+		return fmt.Errorf("rename key %s has an unknown kind %q, expected table, enum or value", from, kind)
+	}
+	if to == "" {
+		return fmt.Errorf("rename for %s must not be empty", from)
+	}
+	if !zigIdentifierPattern.MatchString(to) {
+		return fmt.Errorf("rename for %s is not a valid Zig identifier: %s", from, to)
+	}
+	switch kind {
+	case "value":
+	case "column":
+		if zigKeywords[to] || isZigPrimitive(to) {
+			return fmt.Errorf("rename for %s is a Zig keyword or primitive type: %s", from, to)
+		}
+		if slices.Contains(reservedColumnNames, to) {
+			return fmt.Errorf("rename for %s is reserved by the generated code: %s", from, to)
+		}
+	default:
+		if zigKeywords[to] || isZigPrimitive(to) {
+			return fmt.Errorf("rename for %s is a Zig keyword or primitive type: %s", from, to)
+		}
+	}
+	return nil
+}
+
+// modelsFileDeclarations are the top level names declared by the generated
+// models files besides the models, enums and namespaces.
+var modelsFileDeclarations = []string{
+	"std", "Allocator", "pg", "zqlite", "enums", "ColumnMetadata", "deep", "json_format", "debug_format",
+}
+
+// validateNamespace checks that a schema name can be declared as a namespace
+// in the models file.
+func validateNamespace(schema string) error {
+	if !zigIdentifierPattern.MatchString(schema) {
+		return fmt.Errorf("schema %s is not a valid Zig identifier, disable emit_schema_namespaces to prefix its names instead", schema)
+	}
+	if zigKeywords[schema] || isZigPrimitive(schema) || slices.Contains(modelsFileDeclarations, schema) {
+		return fmt.Errorf("schema %s conflicts with a Zig keyword, primitive type or generated declaration, disable emit_schema_namespaces to prefix its names instead", schema)
+	}
+	return nil
+}
+
+// reservedColumnNames are the field and parameter names declared by the
+// generated code next to the ones generated for columns.
+var reservedColumnNames = []string{"self", "allocator", "__allocator"}
+
+var zigIdentifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+var zigKeywords = map[string]bool{
+	"addrspace": true, "align": true, "allowzero": true, "and": true, "anyframe": true,
+	"anytype": true, "asm": true, "break": true, "callconv": true, "catch": true,
+	"comptime": true, "const": true, "continue": true, "defer": true, "else": true,
+	"enum": true, "errdefer": true, "error": true, "export": true, "extern": true,
+	"fn": true, "for": true, "if": true, "inline": true, "linksection": true,
+	"noalias": true, "noinline": true, "nosuspend": true, "opaque": true, "or": true,
+	"orelse": true, "packed": true, "pub": true, "resume": true, "return": true,
+	"struct": true, "suspend": true, "switch": true, "test": true, "threadlocal": true,
+	"try": true, "union": true, "unreachable": true, "usingnamespace": true, "var": true,
+	"volatile": true, "while": true,
+}
+
+var zigIntegerPattern = regexp.MustCompile(`^[iu][0-9]+$`)
+
+// isZigPrimitive reports whether name is a primitive value or type, which
+// declarations must not shadow.
+func isZigPrimitive(name string) bool {
+	switch name {
+	case "anyerror", "anyopaque", "bool", "comptime_float", "comptime_int", "f16", "f32",
+		"f64", "f80", "f128", "isize", "noreturn", "type", "usize", "void",
+		"true", "false", "null", "undefined":
+		return true
+	case "c_char", "c_short", "c_ushort", "c_int", "c_uint", "c_long", "c_ulong",
+		"c_longlong", "c_ulonglong", "c_longdouble":
+		return true
+	}
+	return zigIntegerPattern.MatchString(name)
+}
+
+// renamed returns the user supplied name for the first of keys found in the
+// rename option, or name when none of them are present.
+func (c Config) renamed(name string, keys ...string) string {
+	for _, key := range keys {
+		if to, ok := c.Rename[key]; ok {
+			return to
+		}
+	}
+	return name
+}
+
+// redacted reports whether a column is listed in the redact option, using the
+// same keys as the rename option, or annotated in the comment of the table
+// column it refers to. Query parameters and results do not carry the comments
+// of their columns, so they are looked up in the catalog.
+func (c Config) redacted(catalog *plugin.Catalog, column *plugin.Column) bool {
+	if columnListed(c.Redact, catalog, column) {
+		return true
+	}
+	return tableColumnAnnotations(catalog, column).Redact
+}
+
+// primaryKey reports whether a column is listed in the primary_keys option,
+// using the same keys as the rename option, or annotated in the comment of the
+// table column it refers to.
+func (c Config) primaryKey(catalog *plugin.Catalog, column *plugin.Column) bool {
+	if columnListed(c.PrimaryKeys, catalog, column) {
+		return true
+	}
+	return tableColumnAnnotations(catalog, column).PrimaryKey
+}
+
+// columnListed reports whether any of the rename keys of a column is in keys.
+func columnListed(keys []string, catalog *plugin.Catalog, column *plugin.Column) bool {
+	for _, key := range columnRenameKeys(catalog, column) {
+		if slices.Contains(keys, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// tableColumnAnnotations returns the annotations in the comment of the table
+// column a column refers to.
+func tableColumnAnnotations(catalog *plugin.Catalog, column *plugin.Column) columnAnnotations {
+	if tableColumn := findColumn(catalog, column); tableColumn != nil {
+		_, flags := parseColumnComment(tableColumn.GetComment())
+		return flags
+	}
+	return columnAnnotations{}
+}
+
+// findColumn returns the table column in the catalog a column refers to, if
+// any.
+func findColumn(catalog *plugin.Catalog, column *plugin.Column) *plugin.Column {
+	table := column.GetTable()
+	if table.GetName() == "" {
+		return nil
+	}
+	schemaName := table.GetSchema()
+	if schemaName == "" {
+		schemaName = catalog.GetDefaultSchema()
+	}
+	for _, schema := range catalog.GetSchemas() {
+		if schema.GetName() != schemaName {
+			continue
+		}
+		for _, t := range schema.GetTables() {
+			if t.GetRel().GetName() != table.GetName() {
+				continue
+			}
+			for _, c := range t.GetColumns() {
+				if c.GetName() == column.GetName() {
+					return c
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// tableKey returns the rename key for a table or type identifier. Identifiers
+// in the default schema are referenced by name only.
+func tableKey(catalog *plugin.Catalog, id *plugin.Identifier) string {
+	if id.GetSchema() == "" || id.GetSchema() == catalog.GetDefaultSchema() {
+		return id.GetName()
+	}
+	return fmt.Sprintf("%s.%s", id.GetSchema(), id.GetName())
+}
+
+// columnRenameKeys returns the rename keys for a column, most specific first.
+func columnRenameKeys(catalog *plugin.Catalog, column *plugin.Column) []string {
+	if column.GetTable().GetName() == "" {
+		return []string{column.GetName()}
+	}
+	return []string{
+		fmt.Sprintf("%s.%s", tableKey(catalog, column.GetTable()), column.GetName()),
+		column.GetName(),
+	}
+}
+
+// identifierSet records the SQL names that generated Zig identifiers
+// originate from, so that two SQL names mapping to the same Zig name can be
+// reported instead of producing duplicate declarations.
+type identifierSet struct {
+	kind  string
+	names map[string]string
+}
+
+func newIdentifierSet(kind string) *identifierSet {
+	return &identifierSet{kind: kind, names: make(map[string]string)}
+}
+
+func (s *identifierSet) add(sqlName, zigName string) error {
+	if prev, ok := s.names[zigName]; ok && prev != sqlName {
+		return fmt.Errorf("%s %q and %q both map to the Zig name %q", s.kind, prev, sqlName, zigName)
+	}
+	s.names[zigName] = sqlName
+	return nil
+}
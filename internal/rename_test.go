@@ -0,0 +1,246 @@
+package zig
+
+import (
+	"testing"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+// renameRequest returns a request with an enum type and a column sharing the
+// name "status".
+func renameRequest() *plugin.GenerateRequest {
+	return &plugin.GenerateRequest{
+		Settings: &plugin.Settings{Engine: enginePostgres},
+		Catalog: &plugin.Catalog{
+			DefaultSchema: "public",
+			Schemas: []*plugin.Schema{{
+				Name:  "public",
+				Enums: []*plugin.Enum{{Name: "status", Vals: []string{"open", "done"}}},
+				Tables: []*plugin.Table{{
+					Rel: &plugin.Identifier{Name: "status"},
+					Columns: []*plugin.Column{{
+						Name:    "status",
+						NotNull: true,
+						Table:   &plugin.Identifier{Name: "status"},
+						Type:    &plugin.Identifier{Name: "status"},
+					}},
+				}},
+			}},
+		},
+	}
+}
+
+func TestRenameKinds(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		rename    map[string]string
+		wantEnum  string
+		wantModel string
+		wantField string
+		// The enum and the model are both declared in the models file
+		wantErr bool
+	}{
+		{
+			name:      "none",
+			wantEnum:  "Status",
+			wantModel: "Status",
+			wantField: "status",
+			wantErr:   true,
+		},
+		{
+			name:      "enum",
+			rename:    map[string]string{"enum:status": "TaskStatus"},
+			wantEnum:  "TaskStatus",
+			wantModel: "Status",
+			wantField: "status",
+		},
+		{
+			name:      "table",
+			rename:    map[string]string{"table:status": "Task"},
+			wantEnum:  "Status",
+			wantModel: "Task",
+			wantField: "status",
+		},
+		{
+			name:      "column",
+			rename:    map[string]string{"enum:status": "TaskStatus", "status": "state"},
+			wantEnum:  "TaskStatus",
+			wantModel: "Status",
+			wantField: "state",
+		},
+		{
+			name:      "qualified column",
+			rename:    map[string]string{"table:status": "Task", "status.status": "state"},
+			wantEnum:  "Status",
+			wantModel: "Task",
+			wantField: "state",
+		},
+		{
+			name:      "table renamed to the enum name",
+			rename:    map[string]string{"enum:status": "TaskStatus", "table:status": "TaskStatus"},
+			wantEnum:  "TaskStatus",
+			wantModel: "TaskStatus",
+			wantField: "status",
+			wantErr:   true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := renameRequest()
+			var conf Config
+			conf.Default(req)
+			conf.Rename = tc.rename
+			if err := conf.Validate(req); err != nil {
+				t.Fatal(err)
+			}
+			enums, err := buildEnums(conf, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			models, err := buildModels(conf, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := enums[0].ZigName; got != tc.wantEnum {
+				t.Errorf("enum name = %q, want %q", got, tc.wantEnum)
+			}
+			if got := models[0].StructName; got != tc.wantModel {
+				t.Errorf("model name = %q, want %q", got, tc.wantModel)
+			}
+			if got := models[0].Fields[0].Name; got != tc.wantField {
+				t.Errorf("field name = %q, want %q", got, tc.wantField)
+			}
+			err = checkModelsFileNames(models, enums)
+			if tc.wantErr && err == nil {
+				t.Error("checkModelsFileNames() = nil, want an error")
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("checkModelsFileNames() = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestModelsFileNames(t *testing.T) {
+	models := []Struct{
+		{ID: Identifier{Schema: "public", Name: "users"}, StructName: "User"},
+		{ID: Identifier{Schema: "billing", Name: "invoices"}, StructName: "Invoice", Namespace: "billing"},
+	}
+	for _, tc := range []struct {
+		name  string
+		enums []Enum
+		want  string
+	}{
+		{
+			name:  "distinct",
+			enums: []Enum{{Name: "user_role", ZigName: "UserRole"}, {Name: "status", ZigName: "Status", Namespace: "billing"}},
+		},
+		{
+			name:  "top level",
+			enums: []Enum{{Name: "user", ZigName: "User"}},
+			want:  `models file declarations "table public.users" and "enum user" both map to the Zig name "User"`,
+		},
+		{
+			name:  "namespace",
+			enums: []Enum{{Name: "billing", ZigName: "billing"}},
+			want:  `models file declarations "schema billing" and "enum billing" both map to the Zig name "billing"`,
+		},
+		{
+			name:  "inside namespace",
+			enums: []Enum{{Name: "invoice", ZigName: "Invoice", Namespace: "billing"}},
+			want:  `models file declarations "table billing.invoices" and "enum invoice" both map to the Zig name "billing.Invoice"`,
+		},
+		{
+			name:  "generated declaration",
+			enums: []Enum{{Name: "allocator", ZigName: "Allocator"}},
+			want:  `models file declarations "generated Allocator" and "enum allocator" both map to the Zig name "Allocator"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkModelsFileNames(models, tc.enums)
+			if tc.want == "" && err != nil {
+				t.Fatalf("checkModelsFileNames() = %v, want nil", err)
+			}
+			if tc.want != "" && (err == nil || err.Error() != tc.want) {
+				t.Fatalf("checkModelsFileNames() = %v, want %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateRename(t *testing.T) {
+	for _, tc := range []struct {
+		from, to string
+		valid    bool
+	}{
+		{"users.email", "email_address", true},
+		{"table:users", "Account", true},
+		{"enum:user_role", "Role", true},
+		{"value:user_role.admin", "administrator", true},
+		{"value:user_role.admin", "const", true},
+		{"users.email", "", false},
+		{"users.email", "email-address", false},
+		{"users.email", "1st", false},
+		{"users.email", "const", false},
+		{"users.email", "type", false},
+		{"users.email", "u8", false},
+		{"users.email", "i32", false},
+		{"users.email", "self", false},
+		{"users.email", "allocator", false},
+		{"users.notes", "__allocator", false},
+		{"email", "bool", false},
+		{"table:users", "type", false},
+		{"enum:user_role", "u8", false},
+		{"type:users", "Account", false},
+	} {
+		err := validateRename(tc.from, tc.to)
+		if tc.valid && err != nil {
+			t.Errorf("validateRename(%q, %q) = %v, want nil", tc.from, tc.to, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("validateRename(%q, %q) = nil, want an error", tc.from, tc.to)
+		}
+	}
+}
+
+func TestValidateNamespace(t *testing.T) {
+	for _, tc := range []struct {
+		schema string
+		valid  bool
+	}{
+		{"billing", true},
+		{"Billing_2", true},
+		{"type", false},
+		{"u32", false},
+		{"std", false},
+		{"pg", false},
+		{"enums", false},
+		{"my-schema", false},
+		{"2fa", false},
+	} {
+		err := validateNamespace(tc.schema)
+		if tc.valid && err != nil {
+			t.Errorf("validateNamespace(%q) = %v, want nil", tc.schema, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("validateNamespace(%q) = nil, want an error", tc.schema)
+		}
+	}
+}
+
+func TestSchemaNamespaceConflict(t *testing.T) {
+	req := renameRequest()
+	req.Catalog.Schemas = append(req.Catalog.Schemas, &plugin.Schema{
+		Name:   "type",
+		Enums:  []*plugin.Enum{{Name: "kind", Vals: []string{"a"}}},
+		Tables: []*plugin.Table{{Rel: &plugin.Identifier{Schema: "type", Name: "items"}}},
+	})
+	var conf Config
+	conf.Default(req)
+	conf.EmitSchemaNamespaces = true
+	if _, err := buildModels(conf, req); err == nil {
+		t.Error("buildModels() = nil, want an error for schema type")
+	}
+	if _, err := buildEnums(conf, req); err == nil {
+		t.Error("buildEnums() = nil, want an error for schema type")
+	}
+}
@@ -0,0 +1,141 @@
+package zig
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const rootFilename = "root.zig"
+
+// rootReserved are the names declared by the root file besides the models and
+// the queries files.
+var rootReserved = []string{"std", "Allocator", "pg", "zqlite", "models", "Querier", "ConnQuerier", "PoolQuerier", "NoHooks"}
+
+// RootFile is a queries file as seen from the root file, which imports it
+// under Name and holds its Querier in a field of the same name.
+type RootFile struct {
+	Name             string
+	File             string
+	Queries          []Query
+	ManagedAllocator bool
+}
+
+// querierReserved are the names declared by the unified Querier besides the
+// fields holding the Queriers of the files and the query methods.
+var querierReserved = []string{"Self", "init"}
+
+// buildRootFiles returns the queries files imported by the root file, failing
+// when the name of a file collides with another declaration of the root file,
+// or the methods of the unified Querier collide across files.
+func buildRootFiles(conf Config, queries []Query, models []Struct, enums []Enum) ([]RootFile, error) {
+	names := newIdentifierSet("root declarations")
+	for _, name := range append(rootReserved, modelRoots(models, enums)...) {
+		if err := names.add(name, name); err != nil {
+			return nil, err
+		}
+	}
+	sourceNames, sourceQueries := groupQueries(queries)
+	files := make([]RootFile, 0, len(sourceNames))
+	for _, sourceName := range sourceNames {
+		name := rootIdentifier(sourceName)
+		if err := names.add(sourceName, name); err != nil {
+			return nil, err
+		}
+		files = append(files, RootFile{
+			Name:             name,
+			File:             sourceName + conf.OutputFilesSuffix,
+			Queries:          sourceQueries[sourceName],
+			ManagedAllocator: querierAllocator(conf, sourceQueries[sourceName]),
+		})
+	}
+	if err := checkQuerierMethods(files); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// checkQuerierMethods fails when two declarations of the unified Querier share
+// a name, naming the queries files they come from.
+func checkQuerierMethods(files []RootFile) error {
+	names := newIdentifierSet("unified Querier declarations")
+	for _, name := range querierReserved {
+		if err := names.add(name, name); err != nil {
+			return err
+		}
+	}
+	for _, file := range files {
+		if err := names.add("queries file "+file.File, file.Name); err != nil {
+			return err
+		}
+	}
+	for _, file := range files {
+		for _, query := range file.Queries {
+			if err := names.add(fmt.Sprintf("%s in %s", query.SQLCName, query.SourceName), query.MethodName); err != nil {
+				return err
+			}
+			if query.FreeName == "" {
+				continue
+			}
+			if err := names.add(fmt.Sprintf("Free%s in %s", query.SQLCName, query.SourceName), query.FreeName); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// modelRoots returns the top level names declared by the models file, which
+// are the models and enums outside of a namespace and the namespaces.
+func modelRoots(models []Struct, enums []Enum) []string {
+	seen := make(map[string]bool)
+	var roots []string
+	add := func(name string) {
+		root, _, _ := strings.Cut(name, ".")
+		if !seen[root] {
+			seen[root] = true
+			roots = append(roots, root)
+		}
+	}
+	for _, enum := range enums {
+		add(enum.QualifiedName())
+	}
+	for _, model := range models {
+		add(model.QualifiedName())
+	}
+	sort.Strings(roots)
+	return roots
+}
+
+// rootIdentifier returns the name a queries file is imported under, e.g.
+// "api_keys" for queries read from api_keys.sql.
+func rootIdentifier(sourceName string) string {
+	name := strings.TrimSuffix(sourceName, ".sql")
+	var out strings.Builder
+	for i, r := range name {
+		switch {
+		case r == '_' || unicode.IsLetter(r) && r < unicode.MaxASCII:
+			out.WriteRune(r)
+		case unicode.IsDigit(r):
+			if i == 0 {
+				out.WriteRune('_')
+			}
+			out.WriteRune(r)
+		default:
+			out.WriteRune('_')
+		}
+	}
+	return out.String()
+}
+
+// rootManagedAllocator reports whether the unified Querier takes an allocator,
+// which is the case when any of the Queriers of the files does.
+func rootManagedAllocator(files []RootFile) bool {
+	for _, file := range files {
+		if file.ManagedAllocator {
+			return true
+		}
+	}
+	return false
+}
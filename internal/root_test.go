@@ -0,0 +1,64 @@
+package zig
+
+import "testing"
+
+func TestRootQuerierCollisions(t *testing.T) {
+	conf := Config{OutputFilesSuffix: ".zig"}
+	for _, tc := range []struct {
+		name    string
+		queries []Query
+		wantErr string
+	}{
+		{
+			name: "distinct methods",
+			queries: []Query{
+				{SQLCName: "GetUser", MethodName: "getUser", SourceName: "users.sql"},
+				{SQLCName: "GetOrder", MethodName: "getOrder", SourceName: "orders.sql"},
+			},
+		},
+		{
+			name: "methods across files",
+			queries: []Query{
+				{SQLCName: "GetUser", MethodName: "getUser", SourceName: "users.sql"},
+				{SQLCName: "get_user", MethodName: "getUser", SourceName: "orders.sql"},
+			},
+			wantErr: `unified Querier declarations "get_user in orders.sql" and "GetUser in users.sql" both map to the Zig name "getUser"`,
+		},
+		{
+			name: "method and free helper",
+			queries: []Query{
+				{SQLCName: "ListUsers", MethodName: "listUsers", FreeName: "freeListUsers", SourceName: "users.sql"},
+				{SQLCName: "FreeListUsers", MethodName: "freeListUsers", SourceName: "admin.sql"},
+			},
+			wantErr: `unified Querier declarations "FreeListUsers in admin.sql" and "FreeListUsers in users.sql" both map to the Zig name "freeListUsers"`,
+		},
+		{
+			name: "method and file field",
+			queries: []Query{
+				{SQLCName: "Users", MethodName: "users", SourceName: "orders.sql"},
+				{SQLCName: "GetUser", MethodName: "getUser", SourceName: "users.sql"},
+			},
+			wantErr: `unified Querier declarations "queries file users.sql.zig" and "Users in orders.sql" both map to the Zig name "users"`,
+		},
+		{
+			name: "method named init",
+			queries: []Query{
+				{SQLCName: "Init", MethodName: "init", SourceName: "setup.sql"},
+			},
+			wantErr: `unified Querier declarations "init" and "Init in setup.sql" both map to the Zig name "init"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := buildRootFiles(conf, tc.queries, nil, nil)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.wantErr {
+				t.Fatalf("buildRootFiles() error = %v, want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
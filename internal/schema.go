@@ -0,0 +1,48 @@
+package zig
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+const schemaFilename = "schema.zig"
+
+// schemaFile is a schema file read by sqlc and embedded in the generated
+// code.
+type schemaFile struct {
+	// The path of the file relative to the sqlc configuration
+	Name string
+	// The path of the file relative to the output directory, as passed to
+	// @embedFile
+	Path string
+}
+
+// schemaFiles returns the schema files of the request. sqlc only passes the
+// paths of the files to plugins, so they are embedded by path relative to the
+// output directory. Directories cannot be listed and are rejected. With
+// emit_root the output directory is the root of the Zig module, which
+// @embedFile cannot reach out of.
+func schemaFiles(conf Config, req *plugin.GenerateRequest) ([]schemaFile, error) {
+	out := req.GetSettings().GetCodegen().GetOut()
+	if out == "" {
+		out = "."
+	}
+	var files []schemaFile
+	for _, name := range req.GetSettings().GetSchema() {
+		if !strings.EqualFold(filepath.Ext(name), ".sql") {
+			return nil, fmt.Errorf("embed_schema requires schema files ending in .sql, got %s", name)
+		}
+		path, err := filepath.Rel(out, name)
+		if err != nil {
+			return nil, fmt.Errorf("embed_schema: %w", err)
+		}
+		if conf.EmitRoot && (path == ".." || strings.HasPrefix(path, ".."+string(filepath.Separator))) {
+			return nil, fmt.Errorf("embed_schema with emit_root requires schema files inside the output directory %s, got %s", out, name)
+		}
+		files = append(files, schemaFile{Name: name, Path: filepath.ToSlash(path)})
+	}
+	return files, nil
+}
@@ -0,0 +1,52 @@
+package zig
+
+import (
+	"testing"
+
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+)
+
+func TestSchemaFiles(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		conf     Config
+		out      string
+		schema   string
+		wantPath string
+		wantErr  string
+	}{
+		{name: "same directory", out: ".", schema: "schema.sql", wantPath: "schema.sql"},
+		{name: "parent directory", out: "src/gen", schema: "src/schema.sql", wantPath: "../schema.sql"},
+		{name: "subdirectory with root", conf: Config{EmitRoot: true}, out: "src", schema: "src/db/schema.sql", wantPath: "db/schema.sql"},
+		{
+			name:    "parent directory with root",
+			conf:    Config{EmitRoot: true},
+			out:     "src/gen",
+			schema:  "src/schema.sql",
+			wantErr: "embed_schema with emit_root requires schema files inside the output directory src/gen, got src/schema.sql",
+		},
+		{name: "directory", out: ".", schema: "schema", wantErr: "embed_schema requires schema files ending in .sql, got schema"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &plugin.GenerateRequest{
+				Settings: &plugin.Settings{
+					Schema:  []string{tc.schema},
+					Codegen: &plugin.Codegen{Out: tc.out},
+				},
+			}
+			files, err := schemaFiles(tc.conf, req)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("schemaFiles() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(files) != 1 || files[0].Path != tc.wantPath {
+				t.Fatalf("schemaFiles() = %+v, want path %q", files, tc.wantPath)
+			}
+		})
+	}
+}
@@ -13,9 +13,9 @@ import (
 func engineTemplateFuncs(t *template.Template, req *plugin.GenerateRequest) template.FuncMap {
 	switch req.GetSettings().GetEngine() {
 	case enginePostgres:
-		return postgresqlTemplateFuncs(t)
+		return postgresqlTemplateFuncs(t, req)
 	case engineSqlite:
-		return sqliteTemplateFuncs(t)
+		return sqliteTemplateFuncs(t, req)
 	default:
 		return template.FuncMap{}
 	}
@@ -47,6 +47,8 @@ func commonTemplateFuncs(t *template.Template) template.FuncMap {
 		"hasNonScalarFields": func(s Struct) bool {
 			return hasNonScalarFields(s)
 		},
+		"modelFilename": modelFilename,
+		"api":           api,
 		"multilineStringLiteral": func(s string, indent int) string {
 			var out strings.Builder
 			lines := strings.Split(s, "\n")
@@ -69,10 +71,78 @@ func commonTemplateFuncs(t *template.Template) template.FuncMap {
 				if q.Ret.Emit {
 					return q.Ret.Struct.StructName
 				}
-				return fmt.Sprintf("models.%s", q.Ret.Struct.StructName)
+				return fmt.Sprintf("models.%s", q.Ret.Struct.QualifiedName())
 			}
 			return fmt.Sprintf("%s", q.Ret.Field.ZigID())
 		},
+		"methodReturnType": func(conf Config, q Query, local string) string {
+			return methodReturnType(conf, q, local)
+		},
+		"interfaceQueries": func(queries []Query) []Query {
+			// Methods taking a context are generic and cannot be called
+			// through a vtable
+			var out []Query
+			for _, q := range queries {
+				if !q.Config.UseContext {
+					out = append(out, q)
+				}
+			}
+			return out
+		},
+		"querierParams": func(conf Config) string {
+			if conf.EmitHooks {
+				return "comptime T: type, comptime Hooks: type"
+			}
+			return "comptime T: type"
+		},
+		"querierArgs": func(conf Config, conn, hooks string) string {
+			if conf.EmitHooks {
+				return conn + ", " + hooks
+			}
+			return conn
+		},
+		"hasJSONStructs": func(queries []Query) bool {
+			for _, q := range queries {
+				if q.Ret != nil && q.Ret.Struct != nil && q.Ret.Emit && !q.Config.UseContext {
+					return true
+				}
+			}
+			return false
+		},
+		"hasFormatStructs": func(queries []Query) bool {
+			for _, q := range queries {
+				if q.Ret != nil && q.Ret.Struct != nil && q.Ret.Emit && !q.Config.UseContext {
+					return true
+				}
+				for _, arg := range q.Args {
+					if arg.Struct != nil && arg.Emit {
+						return true
+					}
+				}
+			}
+			return false
+		},
+		"jsonWriteCall": func(f Field, value string) string {
+			if f.Array {
+				return fmt.Sprintf("json_format.array(jw, %s, json_format.%s)", value, f.JSONFormat)
+			}
+			return fmt.Sprintf("json_format.%s(jw, %s)", f.JSONFormat, value)
+		},
+		"formatCall": func(f Field, value string) string {
+			kind := formatKind(f)
+			if f.Array {
+				return fmt.Sprintf("debug_format.array(writer, %s, debug_format.%s)", value, kind)
+			}
+			return fmt.Sprintf("debug_format.%s(writer, %s)", kind, value)
+		},
+		"freeValue": freeValue,
+		"paramNames": func(params []funcParam) string {
+			names := make([]string, 0, len(params))
+			for _, param := range params {
+				names = append(names, param.Name)
+			}
+			return strings.Join(names, ", ")
+		},
 		"errorUnionType": func(q Query) string {
 			return pascalCase(fmt.Sprintf("%sResult", q.MethodName))
 		},
@@ -131,10 +201,16 @@ func commonTemplateFuncs(t *template.Template) template.FuncMap {
 				"Query":  q,
 			}
 		},
+		"modelWithConfig": func(conf Config, s Struct) map[string]any {
+			return map[string]any{
+				"Config": conf,
+				"Model":  s,
+			}
+		},
 	}
 }
 
-func postgresqlTemplateFuncs(_ *template.Template) template.FuncMap {
+func postgresqlTemplateFuncs(_ *template.Template, req *plugin.GenerateRequest) template.FuncMap {
 	return template.FuncMap{
 		"hasPgTypes": func(models []Struct) bool {
 			for _, model := range models {
@@ -178,6 +254,9 @@ func postgresqlTemplateFuncs(_ *template.Template) template.FuncMap {
 			}
 			return "conn.query"
 		},
+		"needsAllocator": func(q Query) bool {
+			return pgNeedsAllocator(q)
+		},
 		"fieldScanType": func(f Field) string {
 			if f.Nullable {
 				return fmt.Sprintf("?%s", f.ZigID())
@@ -185,33 +264,29 @@ func postgresqlTemplateFuncs(_ *template.Template) template.FuncMap {
 			return f.ZigID()
 		},
 		"queryFuncArgs": func(conf Config, q Query) string {
-			var out strings.Builder
-			out.WriteString("self: Self")
-			if conf.UnmanagedAllocations && !conf.UseContext {
-				if q.RequiresAllocations() {
-					out.WriteString(", allocator: Allocator")
-				}
-			}
-			if conf.UseContext && (conf.PGErrorUnions || q.Cmd != metadata.CmdExec) {
-				out.WriteString(", ctx: anytype")
-			}
-			for i, name := range q.ArgNames() {
-				arg := q.Args[i]
-				out.WriteString(", ")
-				if arg.Struct != nil {
-					out.WriteString(fmt.Sprintf("%s: %s", name, arg.Struct.StructName))
-				} else {
-					switch arg.Field.ZigType {
-					case "pg.Numeric":
-						out.WriteString(fmt.Sprintf("%s: f64", name))
-					case "pg.Cidr":
-						out.WriteString(fmt.Sprintf("%s: []const u8", name))
-					default:
-						out.WriteString(fmt.Sprintf("%s: %s", name, arg.Field.ZigID()))
-					}
-				}
-			}
-			return out.String()
+			return methodArgs(pgQueryParams(conf, q, ""))
+		},
+		"untimedFuncArgs": func(conf Config, q Query) string {
+			return methodArgs(append([]funcParam{{Name: "conn", Type: "*pg.Conn"}}, pgQueryParams(conf, q, "")...))
+		},
+		"freeResult": freeResult,
+		"fixtureFields": func(s Struct) []Field {
+			return fixtureColumns(s, pgGeneratedColumn)
+		},
+		"fixtureType": func(f Field) string {
+			return fixtureType(f, pgFixtureBaseType(f))
+		},
+		"fixtureDefault": func(f Field) string {
+			return fixtureDefault(f, pgFixtureBaseType(f))
+		},
+		"fixtureBind": pgExecParam,
+		"fixtureInsertSQL": func(s Struct) string {
+			return fixtureInsertSQL(fixtureTable(req.GetCatalog(), s), fixtureColumns(s, pgGeneratedColumn), func(i int) string {
+				return fmt.Sprintf("$%d", i)
+			})
+		},
+		"queryParams": func(conf Config, q Query, local string) []funcParam {
+			return pgQueryParams(conf, q, local)
 		},
 		"queryExecParams": func(q Query, indent int) string {
 			var out strings.Builder
@@ -231,10 +306,10 @@ func postgresqlTemplateFuncs(_ *template.Template) template.FuncMap {
 						if i != 0 {
 							out.WriteString(fmt.Sprintf(",\n%s", strings.Repeat(" ", indent)))
 						}
-						out.WriteString(fmt.Sprintf("%s.%s", name, field.Name))
+						out.WriteString(pgExecParam(field, fmt.Sprintf("%s.%s", name, field.Name)))
 					}
 				} else {
-					out.WriteString(name)
+					out.WriteString(pgExecParam(*arg.Field, name))
 				}
 				out.WriteString(",\n")
 				if i == len(q.Args)-1 {
@@ -247,7 +322,57 @@ func postgresqlTemplateFuncs(_ *template.Template) template.FuncMap {
 	}
 }
 
-func sqliteTemplateFuncs(_ *template.Template) template.FuncMap {
+// pgQueryParams returns the parameters of the method generated for a query on
+// the pg.zig backend, not including the receiver. Types declared inside the
+// Querier are prefixed with local.
+func pgQueryParams(conf Config, q Query, local string) []funcParam {
+	return queryParams(conf, q, conf.UnmanagedAllocations && pgNeedsAllocator(q), local, func(f Field) string {
+		switch f.ZigType {
+		case "pg.Numeric":
+			return "f64"
+		case "pg.Cidr":
+			return "[]const u8"
+		default:
+			return f.ZigID()
+		}
+	})
+}
+
+// pgNeedsAllocator reports whether the generated method for a query allocates,
+// either for its results or for the server error returned in a pg.Error union.
+func pgNeedsAllocator(q Query) bool {
+	if q.Config.UseContext {
+		return false
+	}
+	return q.RequiresAllocations() || q.Config.PGErrorUnions
+}
+
+// pgExecParam returns the expression used to bind a field as a query
+// parameter. Enums with renamed values are bound by their database label.
+func pgExecParam(f Field, expr string) string {
+	if !f.EnumMapped {
+		return expr
+	}
+	if f.Nullable {
+		return fmt.Sprintf("if (%s) |value| value.toSql() else null", expr)
+	}
+	return fmt.Sprintf("%s.toSql()", expr)
+}
+
+// sqliteQueryParams returns the parameters of the method generated for a query
+// on the zqlite.zig backend, not including the receiver. Types declared inside
+// the Querier are prefixed with local.
+func sqliteQueryParams(conf Config, q Query, local string) []funcParam {
+	allocator := conf.UnmanagedAllocations && !conf.UseContext && q.RequiresAllocations()
+	return queryParams(conf, q, allocator, local, func(f Field) string {
+		if f.ZigType == "zqlite.Blob" {
+			return "[]const u8"
+		}
+		return f.ZigID()
+	})
+}
+
+func sqliteTemplateFuncs(_ *template.Template, req *plugin.GenerateRequest) template.FuncMap {
 	return template.FuncMap{
 		"isBlob": func(f Field) bool {
 			return f.ZigType == "zqlite.Blob"
@@ -288,31 +413,33 @@ func sqliteTemplateFuncs(_ *template.Template) template.FuncMap {
 			}
 		},
 		"queryFuncArgs": func(conf Config, q Query) string {
-			var out strings.Builder
-			out.WriteString("self: Self")
-			if conf.UnmanagedAllocations && !conf.UseContext {
-				if q.RequiresAllocations() {
-					out.WriteString(", allocator: Allocator")
-				}
-			}
-			if conf.UseContext && (conf.PGErrorUnions || q.Cmd != metadata.CmdExec) {
-				out.WriteString(", ctx: anytype")
+			return methodArgs(sqliteQueryParams(conf, q, ""))
+		},
+		"fixtureFields": func(s Struct) []Field {
+			return fixtureColumns(s, sqliteGeneratedColumn)
+		},
+		"fixtureType": func(f Field) string {
+			return fixtureType(f, sqliteFixtureBaseType(f))
+		},
+		"fixtureDefault": func(f Field) string {
+			return fixtureDefault(f, sqliteFixtureBaseType(f))
+		},
+		"fixtureBind": func(f Field, expr string) string {
+			if f.ZigType != "zqlite.Blob" {
+				return expr
 			}
-			for i, name := range q.ArgNames() {
-				arg := q.Args[i]
-				out.WriteString(", ")
-				if arg.Struct != nil {
-					out.WriteString(fmt.Sprintf("%s: %s", name, arg.Struct.StructName))
-				} else {
-					switch arg.Field.ZigType {
-					case "zqlite.Blob":
-						out.WriteString(fmt.Sprintf("%s: []const u8", name))
-					default:
-						out.WriteString(fmt.Sprintf("%s: %s", name, arg.Field.ZigID()))
-					}
-				}
+			if f.Nullable {
+				return fmt.Sprintf("if (%s) |value| zqlite.blob(value) else null", expr)
 			}
-			return out.String()
+			return fmt.Sprintf("zqlite.blob(%s)", expr)
+		},
+		"fixtureInsertSQL": func(s Struct) string {
+			return fixtureInsertSQL(fixtureTable(req.GetCatalog(), s), fixtureColumns(s, sqliteGeneratedColumn), func(i int) string {
+				return fmt.Sprintf("?%d", i)
+			})
+		},
+		"queryParams": func(conf Config, q Query, local string) []funcParam {
+			return sqliteQueryParams(conf, q, local)
 		},
 		"queryExecParams": func(q Query, indent int) string {
 			var out strings.Builder
@@ -355,3 +482,209 @@ func sqliteTemplateFuncs(_ *template.Template) template.FuncMap {
 		},
 	}
 }
+
+// funcParam is a single parameter of a generated function.
+type funcParam struct {
+	Name string
+	Type string
+}
+
+// queryParams returns the parameters of the method generated for a query, not
+// including the receiver. fieldType returns the type used for inline
+// parameters.
+func queryParams(conf Config, q Query, allocator bool, local string, fieldType func(Field) string) []funcParam {
+	var params []funcParam
+	if allocator {
+		params = append(params, funcParam{Name: "allocator", Type: "Allocator"})
+	}
+	if conf.UseContext && (conf.PGErrorUnions || q.Cmd != metadata.CmdExec) {
+		params = append(params, funcParam{Name: "ctx", Type: "anytype"})
+	}
+	for i, name := range q.ArgNames() {
+		arg := q.Args[i]
+		if arg.Struct != nil {
+			params = append(params, funcParam{Name: name, Type: local + arg.Struct.StructName})
+		} else {
+			params = append(params, funcParam{Name: name, Type: fieldType(*arg.Field)})
+		}
+	}
+	return params
+}
+
+// methodArgs formats the parameters of a Querier method.
+func methodArgs(params []funcParam) string {
+	var out strings.Builder
+	out.WriteString("self: Self")
+	for _, param := range params {
+		out.WriteString(fmt.Sprintf(", %s: %s", param.Name, param.Type))
+	}
+	return out.String()
+}
+
+// methodReturnType returns the type returned by the method generated for a
+// query, without the error union. Types declared inside the Querier are
+// prefixed with local.
+func methodReturnType(conf Config, q Query, local string) string {
+	if conf.UseContext {
+		return "void"
+	}
+	if conf.PGErrorUnions {
+		return local + pascalCase(fmt.Sprintf("%sResult", q.MethodName))
+	}
+	var prefix string
+	if q.Cmd == metadata.CmdMany {
+		prefix = "[]"
+	}
+	if q.Ret == nil {
+		return "void"
+	}
+	if q.Ret.Struct != nil {
+		if q.Ret.Emit {
+			return prefix + local + q.Ret.Struct.StructName
+		}
+		return prefix + "models." + q.Ret.Struct.QualifiedName()
+	}
+	return prefix + q.Ret.Field.ZigID()
+}
+
+// formatKind returns the debug_format function used by the generated format
+// methods to print a field.
+func formatKind(f Field) string {
+	if f.JSONFormat != jsonFormatDefault {
+		return f.JSONFormat
+	}
+	switch {
+	case f.Enum:
+		return "@\"enum\""
+	case f.ZigType == "[]const u8":
+		return "string"
+	case f.ZigType == "pg.Cidr":
+		return "cidr"
+	case f.ZigType == "pg.Numeric":
+		return "numeric"
+	}
+	return "any"
+}
+
+// freeValue returns the statement freeing the memory owned by a single value of
+// a field, not including the items of arrays.
+func freeValue(f Field, value string) string {
+	return freeValueWith("allocator", f, value)
+}
+
+// freeValueWith is freeValue with the expression of the allocator to use.
+func freeValueWith(allocator string, f Field, value string) string {
+	switch f.ZigType {
+	case "pg.Cidr":
+		return fmt.Sprintf("%s.free(%s.address);", allocator, value)
+	case "pg.Numeric":
+		return fmt.Sprintf("%s.free(%s.digits);", allocator, value)
+	default:
+		return fmt.Sprintf("%s.free(%s);", allocator, value)
+	}
+}
+
+// freeResult returns the statements freeing value, as returned by the method
+// generated for a query without the error union, from inside the Querier.
+// Values owning no memory need no statements.
+func freeResult(conf Config, q Query, value string) []string {
+	if conf.UseContext || q.Ret == nil {
+		return nil
+	}
+	allocator := "self.allocator"
+	if conf.UnmanagedAllocations {
+		allocator = "allocator"
+	}
+	if q.Cmd == metadata.CmdMany {
+		switch {
+		case q.FreeName != "" && conf.UnmanagedAllocations:
+			return []string{fmt.Sprintf("self.%s(allocator, %s);", q.FreeName, value)}
+		case q.FreeName != "":
+			return []string{fmt.Sprintf("self.%s(%s);", q.FreeName, value)}
+		default:
+			return []string{fmt.Sprintf("%s.free(%s);", allocator, value)}
+		}
+	}
+	if q.Ret.Struct != nil {
+		if hasNonScalarFields(*q.Ret.Struct) {
+			return []string{value + ".deinit();"}
+		}
+		return nil
+	}
+	field := *q.Ret.Field
+	switch {
+	case field.Array && isNonScalarBaseType(field):
+		return []string{
+			fmt.Sprintf("for (%s) |item| %s", value, freeValueWith(allocator, field, "item")),
+			fmt.Sprintf("%s.free(%s);", allocator, value),
+		}
+	case field.Array:
+		return []string{fmt.Sprintf("%s.free(%s);", allocator, value)}
+	case isNonScalarBaseType(field):
+		return []string{freeValueWith(allocator, field, value)}
+	default:
+		return nil
+	}
+}
+
+// api returns the Zig code of a construct that differs across the Zig releases
+// and backend versions selected by zig_version and backend_version. Every
+// version switch of the templates goes through it.
+func api(conf Config, construct string, args ...string) (string, error) {
+	version, _ := conf.backendVersion()
+	arity := map[string]int{
+		"typeTag":   1,
+		"callconvC": 0,
+		"release":   2,
+		"drain":     1,
+		"blobType":  0,
+	}
+	n, ok := arity[construct]
+	if !ok {
+		return "", fmt.Errorf("api: unknown construct %s", construct)
+	}
+	if len(args) != n {
+		return "", fmt.Errorf("api: %s takes %d arguments, got %d", construct, n, len(args))
+	}
+	switch construct {
+	case "typeTag":
+		return typeTag(conf.ZigVersion, args[0]), nil
+	case "callconvC":
+		// The C calling convention
+		if conf.ZigVersion == Zig013 {
+			return ".C", nil
+		}
+		return ".c", nil
+	case "release":
+		// Returns the connection args[1] to the pool args[0]
+		if version.PoolRelease {
+			return fmt.Sprintf("%s.release(%s)", args[0], args[1]), nil
+		}
+		return args[1] + ".release()", nil
+	case "drain":
+		// Drains the rows of the result args[0] left unread before it is
+		// deinitialized, when deinit does not
+		if version.DeinitDrains {
+			return "", nil
+		}
+		return fmt.Sprintf("defer %s.drain() catch {};", args[0]), nil
+	default:
+		// The type of blob fields in the structs declared by a Querier
+		return version.BlobType, nil
+	}
+}
+
+// typeTag returns the tag of a std.builtin.Type union field for the Zig
+// release, e.g. `.@"struct"` for Zig 0.14 and `.Struct` for Zig 0.13.
+func typeTag(zig ZigVersion, name string) string {
+	if zig == Zig013 {
+		return "." + pascalCase(name)
+	}
+	switch name {
+	case "struct", "enum", "union", "fn", "opaque":
+		// Keywords are quoted
+		return fmt.Sprintf(".@%q", name)
+	default:
+		return "." + name
+	}
+}
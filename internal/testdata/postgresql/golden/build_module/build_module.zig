@@ -0,0 +1,51 @@
+// Generated with sqlc v1.28.0
+//
+// Registers the generated code as a module from build.zig:
+//
+//     const db = @import("build_module.zig");
+//     exe_mod.addImport("db", db.createModule(b, .{ .target = target, .optimize = optimize }));
+//
+// pg is imported from the "pg" dependency of build.zig.zon, see
+// dependencies.zon for the version the generated code is tested with.
+
+const std = @import("std");
+
+pub const Options = struct {
+    target: std.Build.ResolvedTarget,
+    optimize: std.builtin.OptimizeMode,
+    // The directory of the generated code, relative to the build root
+    dir: []const u8 = ".",
+    // The name of the build.zig.zon dependency providing pg
+    dependency: []const u8 = "pg",
+};
+
+// Creates a private module from the generated code.
+pub fn createModule(b: *std.Build, options: Options) *std.Build.Module {
+    const module = b.createModule(moduleOptions(b, options));
+    addImports(b, module, options);
+    return module;
+}
+
+// Creates a module from the generated code, exposed to dependent packages
+// under name.
+pub fn addModule(b: *std.Build, name: []const u8, options: Options) *std.Build.Module {
+    const module = b.addModule(name, moduleOptions(b, options));
+    addImports(b, module, options);
+    return module;
+}
+
+fn moduleOptions(b: *std.Build, options: Options) std.Build.Module.CreateOptions {
+    return .{
+        .root_source_file = b.path(b.pathJoin(&.{ options.dir, "root.zig" })),
+        .target = options.target,
+        .optimize = options.optimize,
+    };
+}
+
+fn addImports(b: *std.Build, module: *std.Build.Module, options: Options) void {
+    const dep = b.dependency(options.dependency, .{
+        .target = options.target,
+        .optimize = options.optimize,
+    });
+    module.addImport("pg", dep.module("pg"));
+}
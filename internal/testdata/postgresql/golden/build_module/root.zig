@@ -0,0 +1,169 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+
+pub const models = @import("models.zig");
+pub const ApiKey = models.ApiKey;
+pub const BillingInvoice = models.BillingInvoice;
+pub const BillingInvoiceStatus = models.BillingInvoiceStatus;
+pub const Order = models.Order;
+pub const Product = models.Product;
+pub const User = models.User;
+pub const UserRole = models.UserRole;
+
+pub const annotated = @import("annotated.sql.zig");
+pub const api_keys = @import("api_keys.sql.zig");
+pub const invoices = @import("invoices.sql.zig");
+pub const orders = @import("orders.sql.zig");
+pub const users = @import("users.sql.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+// Runs the queries of all queries files, holding the Querier of each file in a
+// field named after it.
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+
+        annotated: annotated.Querier(T),
+        api_keys: api_keys.Querier(T),
+        invoices: invoices.Querier(T),
+        orders: orders.Querier(T),
+        users: users.Querier(T),
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{
+                .annotated = annotated.Querier(T).init(allocator, conn),
+                .api_keys = api_keys.Querier(T).init(allocator, conn),
+                .invoices = invoices.Querier(T).init(allocator, conn),
+                .orders = orders.Querier(T).init(allocator, conn),
+                .users = users.Querier(T).init(allocator, conn),
+            };
+        }
+
+        pub fn archiveUser(self: Self, archive_user_params: annotated.Querier(T).ArchiveUserParams) !void {
+            return self.annotated.archiveUser(archive_user_params);
+        }
+
+        pub fn findUser(self: Self, email: []const u8) !annotated.Querier(T).FindUserResult {
+            return self.annotated.findUser(email);
+        }
+
+        //  Streams the id and email of every user.
+        pub fn listUserEmails(self: Self, ctx: anytype) !void {
+            return self.annotated.listUserEmails(ctx);
+        }
+
+        pub fn getAPIKey(self: Self, id: [16]u8) !models.ApiKey {
+            return self.api_keys.getAPIKey(id);
+        }
+
+        pub fn listAPIKeySecrets(self: Self, user_id: i32) ![]api_keys.Querier(T).ListAPIKeySecretsRow {
+            return self.api_keys.listAPIKeySecrets(user_id);
+        }
+
+        pub fn freeListAPIKeySecrets(self: Self, rows: []const api_keys.Querier(T).ListAPIKeySecretsRow) void {
+            self.api_keys.freeListAPIKeySecrets(rows);
+        }
+
+        pub fn createInvoice(self: Self, create_invoice_params: invoices.Querier(T).CreateInvoiceParams) !void {
+            return self.invoices.createInvoice(create_invoice_params);
+        }
+
+        pub fn getInvoiceStatus(self: Self, id: i32) !models.BillingInvoiceStatus {
+            return self.invoices.getInvoiceStatus(id);
+        }
+
+        pub fn getInvoices(self: Self) ![]models.BillingInvoice {
+            return self.invoices.getInvoices();
+        }
+
+        pub fn freeGetInvoices(self: Self, rows: []const models.BillingInvoice) void {
+            self.invoices.freeGetInvoices(rows);
+        }
+
+        pub fn createOrder(self: Self, create_order_params: orders.Querier(T).CreateOrderParams) !void {
+            return self.orders.createOrder(create_order_params);
+        }
+
+        pub fn getOrderByID(self: Self, id: i32) !models.Order {
+            return self.orders.getOrderByID(id);
+        }
+
+        pub fn getOrderPartial(self: Self) ![]orders.Querier(T).GetOrderPartialRow {
+            return self.orders.getOrderPartial();
+        }
+
+        pub fn freeGetOrderPartial(self: Self, rows: []const orders.Querier(T).GetOrderPartialRow) void {
+            self.orders.freeGetOrderPartial(rows);
+        }
+
+        pub fn getOrderTotals(self: Self) ![]pg.Numeric {
+            return self.orders.getOrderTotals();
+        }
+
+        pub fn freeGetOrderTotals(self: Self, rows: []const pg.Numeric) void {
+            self.orders.freeGetOrderTotals(rows);
+        }
+
+        pub fn getOrders(self: Self) ![]models.Order {
+            return self.orders.getOrders();
+        }
+
+        pub fn freeGetOrders(self: Self, rows: []const models.Order) void {
+            self.orders.freeGetOrders(rows);
+        }
+
+        pub fn createUser(self: Self, create_user_params: users.Querier(T).CreateUserParams) !void {
+            return self.users.createUser(create_user_params);
+        }
+
+        pub fn getUser(self: Self, id: i32) !models.User {
+            return self.users.getUser(id);
+        }
+
+        pub fn getUserEmails(self: Self) ![]users.Querier(T).GetUserEmailsRow {
+            return self.users.getUserEmails();
+        }
+
+        pub fn freeGetUserEmails(self: Self, rows: []const users.Querier(T).GetUserEmailsRow) void {
+            self.users.freeGetUserEmails(rows);
+        }
+
+        pub fn getUserIDByEmail(self: Self, email: []const u8) !i32 {
+            return self.users.getUserIDByEmail(email);
+        }
+
+        pub fn getUserIDsByIPAddress(self: Self, ip_address: []const u8) ![]i32 {
+            return self.users.getUserIDsByIPAddress(ip_address);
+        }
+
+        pub fn getUserIDsByRole(self: Self, role: models.UserRole) ![]i32 {
+            return self.users.getUserIDsByRole(role);
+        }
+
+        pub fn getUserIDsBySalaryRange(self: Self, salary_1: f64, salary_2: f64) ![]i32 {
+            return self.users.getUserIDsBySalaryRange(salary_1, salary_2);
+        }
+
+        pub fn getUserNames(self: Self) ![][]const u8 {
+            return self.users.getUserNames();
+        }
+
+        pub fn freeGetUserNames(self: Self, rows: []const []const u8) void {
+            self.users.freeGetUserNames(rows);
+        }
+
+        pub fn getUsers(self: Self) ![]models.User {
+            return self.users.getUsers();
+        }
+
+        pub fn freeGetUsers(self: Self, rows: []const models.User) void {
+            self.users.freeGetUsers(rows);
+        }
+    };
+}
@@ -0,0 +1,229 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+const catalog = @import("queries.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+        
+        const archive_user_sql = 
+            \\UPDATE users SET archived_at = NOW() WHERE id = $1
+        ;
+
+        pub const ArchiveUserParams = struct {
+            id: i32,
+        };
+
+        pub fn archiveUser(self: Self, archive_user_params: ArchiveUserParams) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            _ = try conn.exec(archive_user_sql, .{ 
+                archive_user_params.id,
+            });
+        }
+
+        const find_user_sql = 
+            \\SELECT id, name, email, password, role, ip_address, salary, notes, created_at, updated_at, archived_at FROM users
+            \\WHERE email = $1 LIMIT 1
+        ;
+
+        pub const FindUserResult = union(enum) {
+            user: models.User,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .user => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn findUser(self: Self, email: []const u8) !FindUserResult {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = conn.query(find_user_sql, .{ 
+                email,
+            }) catch |err| {
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+            const row_name = try allocator.dupe(u8, row.get([]const u8, 1));
+            errdefer allocator.free(row_name);
+            const row_email = try allocator.dupe(u8, row.get([]const u8, 2));
+            errdefer allocator.free(row_email);
+            const row_password = try allocator.dupe(u8, row.get([]const u8, 3));
+            errdefer allocator.free(row_password);
+            const row_role = row.get(models.UserRole, 4);
+            const ip_address_cidr = row.get(?pg.Cidr, 5);
+            const row_ip_address: ?pg.Cidr = blk: {
+                if (ip_address_cidr) |cidr| {
+                    break :blk pg.Cidr{
+                        .address = try allocator.dupe(u8, cidr.address),
+                        .netmask = cidr.netmask,
+                        .family = cidr.family,
+                    };
+                }
+                break :blk null;
+            };
+            errdefer if (row_ip_address) |cidr| {
+                allocator.free(cidr.address);
+            };
+            const salary_numeric = row.get(?pg.Numeric, 6);
+            const row_salary: ?pg.Numeric = blk: {
+                if (salary_numeric) |numeric| {
+                    break :blk pg.Numeric{
+                        .number_of_digits = numeric.number_of_digits,
+                        .weight = numeric.weight,
+                        .sign = numeric.sign,
+                        .scale = numeric.scale,
+                        .digits = try allocator.dupe(u8, numeric.digits),
+                    };
+                }
+                break :blk null;
+            };
+            errdefer if (row_salary) |numeric| {
+                allocator.free(numeric.digits);
+            };
+
+            const maybe_notes = row.get(?[]const u8, 7);
+            const row_notes: ?[]const u8 = blk: {
+                if (maybe_notes) |field| {
+                    break :blk try allocator.dupe(u8, field);
+                }
+                break :blk null;
+            };
+            errdefer if (row_notes) |field| {
+                allocator.free(field);
+            };
+            const row_created_at = row.get(i64, 8);
+            const row_updated_at = row.get(i64, 9);
+            const row_archived_at = row.get(?i64, 10);
+
+            return .{
+                .user = .{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .name = row_name,
+                    .email = row_email,
+                    .password = row_password,
+                    .role = row_role,
+                    .ip_address = row_ip_address,
+                    .salary = row_salary,
+                    .notes = row_notes,
+                    .created_at = row_created_at,
+                    .updated_at = row_updated_at,
+                    .archived_at = row_archived_at,
+                }
+            };
+        }
+
+        const list_user_emails_sql = 
+            \\SELECT id, email FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub const ListUserEmailsRow = struct {
+            id: i32,
+            email: []const u8,
+        };
+
+        //  Streams the id and email of every user.
+        pub fn listUserEmails(self: Self, ctx: anytype) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(list_user_emails_sql, .{});
+            defer result.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_email = row.get([]const u8, 1);
+                try ctx.handle(.{
+                    .id = row_id,
+                    .email = row_email,
+                });
+            }
+        }
+
+    };
+}
+
+// The queries of this file, ordered by method name.
+pub const queries = [_]catalog.QueryInfo{
+    .{
+        .name = "archiveUser",
+        .sqlc_name = "ArchiveUser",
+        .file = "annotated.sql",
+        .cmd = ":exec",
+        .sql = ConnQuerier.archive_user_sql,
+        .params = &.{
+            .{ .name = "id", .sql_type = "serial", .nullable = false, .array = false },
+        },
+        .columns = &.{},
+    },
+    .{
+        .name = "findUser",
+        .sqlc_name = "FindUser",
+        .file = "annotated.sql",
+        .cmd = ":one",
+        .sql = ConnQuerier.find_user_sql,
+        .params = &.{
+            .{ .name = "email", .sql_type = "text", .nullable = false, .array = false },
+        },
+        .columns = &.{ "id", "name", "email", "password", "role", "ip_address", "salary", "notes", "created_at", "updated_at", "archived_at" },
+    },
+    .{
+        .name = "listUserEmails",
+        .sqlc_name = "ListUserEmails",
+        .file = "annotated.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.list_user_emails_sql,
+        .params = &.{},
+        .columns = &.{ "id", "email" },
+    },
+};
@@ -0,0 +1,187 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+const catalog = @import("queries.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+        
+        const create_invoice_sql = 
+            \\INSERT INTO billing.invoices (user_id, status, amount, memo) VALUES ($1, $2, $3, $4)
+        ;
+
+        pub const CreateInvoiceParams = struct {
+            user_id: i32,
+            status: models.BillingInvoiceStatus,
+            amount: f64,
+            memo: ?[]const u8 = null,
+        };
+
+        pub fn createInvoice(self: Self, create_invoice_params: CreateInvoiceParams) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            _ = try conn.exec(create_invoice_sql, .{ 
+                create_invoice_params.user_id,
+                create_invoice_params.status,
+                create_invoice_params.amount,
+                create_invoice_params.memo,
+            });
+        }
+
+        const get_invoice_status_sql = 
+            \\SELECT status FROM billing.invoices
+            \\WHERE id = $1
+        ;
+
+        pub fn getInvoiceStatus(self: Self, id: i32) !models.BillingInvoiceStatus {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_invoice_status_sql, .{ 
+                id,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_status = row.get(models.BillingInvoiceStatus, 0);
+
+            return row_status;
+        }
+
+        const get_invoices_sql = 
+            \\SELECT id, user_id, status, amount, memo FROM billing.invoices
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getInvoices(self: Self) ![]models.BillingInvoice {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_invoices_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(models.BillingInvoice).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_user_id = row.get(i32, 1);
+                const row_status = row.get(models.BillingInvoiceStatus, 2);
+                const amount_numeric = row.get(pg.Numeric, 3);const row_amount = pg.Numeric{
+                    .number_of_digits = amount_numeric.number_of_digits,
+                    .weight = amount_numeric.weight,
+                    .sign = amount_numeric.sign,
+                    .scale = amount_numeric.scale,
+                    .digits = try allocator.dupe(u8, amount_numeric.digits),
+                };
+                errdefer allocator.free(row_amount.digits);
+
+                const maybe_memo = row.get(?[]const u8, 4);
+                const row_memo: ?[]const u8 = blk: {
+                    if (maybe_memo) |field| {
+                        break :blk try allocator.dupe(u8, field);
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_memo) |field| {
+                    allocator.free(field);
+                };
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .user_id = row_user_id,
+                    .status = row_status,
+                    .amount = row_amount,
+                    .memo = row_memo,
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getInvoices along with the slice
+        // holding them.
+        pub fn freeGetInvoices(self: Self, rows: []const models.BillingInvoice) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
+
+// The queries of this file, ordered by method name.
+pub const queries = [_]catalog.QueryInfo{
+    .{
+        .name = "createInvoice",
+        .sqlc_name = "CreateInvoice",
+        .file = "invoices.sql",
+        .cmd = ":exec",
+        .sql = ConnQuerier.create_invoice_sql,
+        .params = &.{
+            .{ .name = "user_id", .sql_type = "int4", .nullable = false, .array = false },
+            .{ .name = "status", .sql_type = "billing.invoice_status", .nullable = false, .array = false },
+            .{ .name = "amount", .sql_type = "numeric", .nullable = false, .array = false },
+            .{ .name = "memo", .sql_type = "text", .nullable = true, .array = false },
+        },
+        .columns = &.{},
+    },
+    .{
+        .name = "getInvoiceStatus",
+        .sqlc_name = "GetInvoiceStatus",
+        .file = "invoices.sql",
+        .cmd = ":one",
+        .sql = ConnQuerier.get_invoice_status_sql,
+        .params = &.{
+            .{ .name = "id", .sql_type = "serial", .nullable = false, .array = false },
+        },
+        .columns = &.{ "status" },
+    },
+    .{
+        .name = "getInvoices",
+        .sqlc_name = "GetInvoices",
+        .file = "invoices.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_invoices_sql,
+        .params = &.{},
+        .columns = &.{ "id", "user_id", "status", "amount", "memo" },
+    },
+};
@@ -0,0 +1,587 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+const catalog = @import("queries.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+        
+        const create_user_sql = 
+            \\INSERT INTO users (
+            \\    name, 
+            \\    email, 
+            \\    password, 
+            \\    role, 
+            \\    ip_address,
+            \\    salary,
+            \\    created_at,
+            \\    updated_at
+            \\) VALUES (
+            \\    $1, $2, $3, $4, $5, $6, NOW(), NOW()
+            \\)
+        ;
+
+        pub const CreateUserParams = struct {
+            name: []const u8,
+            email: []const u8,
+            password: []const u8,
+            role: models.UserRole,
+            ip_address: ?[]const u8 = null,
+            salary: ?f64 = null,
+        };
+
+        pub fn createUser(self: Self, create_user_params: CreateUserParams) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            _ = try conn.exec(create_user_sql, .{ 
+                create_user_params.name,
+                create_user_params.email,
+                create_user_params.password,
+                create_user_params.role,
+                create_user_params.ip_address,
+                create_user_params.salary,
+            });
+        }
+
+        const get_user_sql = 
+            \\SELECT id, name, email, password, role, ip_address, salary, notes, created_at, updated_at, archived_at FROM users
+            \\WHERE id = $1 LIMIT 1
+        ;
+
+        pub fn getUser(self: Self, id: i32) !models.User {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_sql, .{ 
+                id,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+            const row_name = try allocator.dupe(u8, row.get([]const u8, 1));
+            errdefer allocator.free(row_name);
+            const row_email = try allocator.dupe(u8, row.get([]const u8, 2));
+            errdefer allocator.free(row_email);
+            const row_password = try allocator.dupe(u8, row.get([]const u8, 3));
+            errdefer allocator.free(row_password);
+            const row_role = row.get(models.UserRole, 4);
+            const ip_address_cidr = row.get(?pg.Cidr, 5);
+            const row_ip_address: ?pg.Cidr = blk: {
+                if (ip_address_cidr) |cidr| {
+                    break :blk pg.Cidr{
+                        .address = try allocator.dupe(u8, cidr.address),
+                        .netmask = cidr.netmask,
+                        .family = cidr.family,
+                    };
+                }
+                break :blk null;
+            };
+            errdefer if (row_ip_address) |cidr| {
+                allocator.free(cidr.address);
+            };
+            const salary_numeric = row.get(?pg.Numeric, 6);
+            const row_salary: ?pg.Numeric = blk: {
+                if (salary_numeric) |numeric| {
+                    break :blk pg.Numeric{
+                        .number_of_digits = numeric.number_of_digits,
+                        .weight = numeric.weight,
+                        .sign = numeric.sign,
+                        .scale = numeric.scale,
+                        .digits = try allocator.dupe(u8, numeric.digits),
+                    };
+                }
+                break :blk null;
+            };
+            errdefer if (row_salary) |numeric| {
+                allocator.free(numeric.digits);
+            };
+
+            const maybe_notes = row.get(?[]const u8, 7);
+            const row_notes: ?[]const u8 = blk: {
+                if (maybe_notes) |field| {
+                    break :blk try allocator.dupe(u8, field);
+                }
+                break :blk null;
+            };
+            errdefer if (row_notes) |field| {
+                allocator.free(field);
+            };
+            const row_created_at = row.get(i64, 8);
+            const row_updated_at = row.get(i64, 9);
+            const row_archived_at = row.get(?i64, 10);
+
+            return .{
+                .__allocator = allocator,
+                .id = row_id,
+                .name = row_name,
+                .email = row_email,
+                .password = row_password,
+                .role = row_role,
+                .ip_address = row_ip_address,
+                .salary = row_salary,
+                .notes = row_notes,
+                .created_at = row_created_at,
+                .updated_at = row_updated_at,
+                .archived_at = row_archived_at,
+            };
+        }
+
+        const get_user_emails_sql = 
+            \\SELECT id, email FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetUserEmailsRow = struct {
+            __allocator: Allocator,
+
+            id: i32,
+            email: []const u8,
+
+            pub fn deinit(self: *const GetUserEmailsRow) void {
+                self.__allocator.free(self.email);
+            }
+        };
+
+        pub fn getUserEmails(self: Self) ![]GetUserEmailsRow {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_emails_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(GetUserEmailsRow).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_email = try allocator.dupe(u8, row.get([]const u8, 1));
+                errdefer allocator.free(row_email);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .email = row_email,
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getUserEmails along with the slice
+        // holding them.
+        pub fn freeGetUserEmails(self: Self, rows: []const GetUserEmailsRow) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+        const get_user_id_by_email_sql = 
+            \\SELECT id FROM users
+            \\WHERE email = $1 LIMIT 1
+        ;
+
+        pub fn getUserIDByEmail(self: Self, email: []const u8) !i32 {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_id_by_email_sql, .{ 
+                email,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+
+            return row_id;
+        }
+
+        const get_user_i_ds_by_ip_address_sql = 
+            \\SELECT id FROM users
+            \\WHERE ip_address = $1
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getUserIDsByIPAddress(self: Self, ip_address: []const u8) ![]i32 {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_i_ds_by_ip_address_sql, .{ 
+                ip_address,
+            });
+            defer result.deinit();
+            var out = std.ArrayList(i32).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                try out.append(row_id);
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        const get_user_i_ds_by_role_sql = 
+            \\SELECT id FROM users
+            \\WHERE role = $1
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getUserIDsByRole(self: Self, role: models.UserRole) ![]i32 {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_i_ds_by_role_sql, .{ 
+                role,
+            });
+            defer result.deinit();
+            var out = std.ArrayList(i32).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                try out.append(row_id);
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        const get_user_i_ds_by_salary_range_sql = 
+            \\SELECT id FROM users
+            \\WHERE salary >= $1 AND salary <= $2
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getUserIDsBySalaryRange(self: Self, salary_1: f64, salary_2: f64) ![]i32 {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_i_ds_by_salary_range_sql, .{ 
+                salary_1,
+                salary_2,
+            });
+            defer result.deinit();
+            var out = std.ArrayList(i32).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                try out.append(row_id);
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        const get_user_names_sql = 
+            \\SELECT name FROM users
+        ;
+
+        pub fn getUserNames(self: Self) ![][]const u8 {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_names_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList([]const u8).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_name = try allocator.dupe(u8, row.get([]const u8, 0));
+                errdefer allocator.free(row_name);
+                try out.append(row_name);
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getUserNames along with the slice
+        // holding them.
+        pub fn freeGetUserNames(self: Self, rows: []const []const u8) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                allocator.free(row);
+            }
+            allocator.free(rows);
+        }
+
+        const get_users_sql = 
+            \\SELECT id, name, email, password, role, ip_address, salary, notes, created_at, updated_at, archived_at FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getUsers(self: Self) ![]models.User {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_users_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(models.User).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_name = try allocator.dupe(u8, row.get([]const u8, 1));
+                errdefer allocator.free(row_name);
+                const row_email = try allocator.dupe(u8, row.get([]const u8, 2));
+                errdefer allocator.free(row_email);
+                const row_password = try allocator.dupe(u8, row.get([]const u8, 3));
+                errdefer allocator.free(row_password);
+                const row_role = row.get(models.UserRole, 4);
+                const ip_address_cidr = row.get(?pg.Cidr, 5);
+                const row_ip_address: ?pg.Cidr = blk: {
+                    if (ip_address_cidr) |cidr| {
+                        break :blk pg.Cidr{
+                            .address = try allocator.dupe(u8, cidr.address),
+                            .netmask = cidr.netmask,
+                            .family = cidr.family,
+                        };
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_ip_address) |cidr| {
+                    allocator.free(cidr.address);
+                };
+                const salary_numeric = row.get(?pg.Numeric, 6);
+                const row_salary: ?pg.Numeric = blk: {
+                    if (salary_numeric) |numeric| {
+                        break :blk pg.Numeric{
+                            .number_of_digits = numeric.number_of_digits,
+                            .weight = numeric.weight,
+                            .sign = numeric.sign,
+                            .scale = numeric.scale,
+                            .digits = try allocator.dupe(u8, numeric.digits),
+                        };
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_salary) |numeric| {
+                    allocator.free(numeric.digits);
+                };
+
+                const maybe_notes = row.get(?[]const u8, 7);
+                const row_notes: ?[]const u8 = blk: {
+                    if (maybe_notes) |field| {
+                        break :blk try allocator.dupe(u8, field);
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_notes) |field| {
+                    allocator.free(field);
+                };
+                const row_created_at = row.get(i64, 8);
+                const row_updated_at = row.get(i64, 9);
+                const row_archived_at = row.get(?i64, 10);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .name = row_name,
+                    .email = row_email,
+                    .password = row_password,
+                    .role = row_role,
+                    .ip_address = row_ip_address,
+                    .salary = row_salary,
+                    .notes = row_notes,
+                    .created_at = row_created_at,
+                    .updated_at = row_updated_at,
+                    .archived_at = row_archived_at,
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getUsers along with the slice
+        // holding them.
+        pub fn freeGetUsers(self: Self, rows: []const models.User) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
+
+// The queries of this file, ordered by method name.
+pub const queries = [_]catalog.QueryInfo{
+    .{
+        .name = "createUser",
+        .sqlc_name = "CreateUser",
+        .file = "users.sql",
+        .cmd = ":exec",
+        .sql = ConnQuerier.create_user_sql,
+        .params = &.{
+            .{ .name = "name", .sql_type = "text", .nullable = false, .array = false },
+            .{ .name = "email", .sql_type = "text", .nullable = false, .array = false },
+            .{ .name = "password", .sql_type = "text", .nullable = false, .array = false },
+            .{ .name = "role", .sql_type = "user_role", .nullable = false, .array = false },
+            .{ .name = "ip_address", .sql_type = "inet", .nullable = true, .array = false },
+            .{ .name = "salary", .sql_type = "numeric", .nullable = true, .array = false },
+        },
+        .columns = &.{},
+    },
+    .{
+        .name = "getUser",
+        .sqlc_name = "GetUser",
+        .file = "users.sql",
+        .cmd = ":one",
+        .sql = ConnQuerier.get_user_sql,
+        .params = &.{
+            .{ .name = "id", .sql_type = "serial", .nullable = false, .array = false },
+        },
+        .columns = &.{ "id", "name", "email", "password", "role", "ip_address", "salary", "notes", "created_at", "updated_at", "archived_at" },
+    },
+    .{
+        .name = "getUserEmails",
+        .sqlc_name = "GetUserEmails",
+        .file = "users.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_user_emails_sql,
+        .params = &.{},
+        .columns = &.{ "id", "email" },
+    },
+    .{
+        .name = "getUserIDByEmail",
+        .sqlc_name = "GetUserIDByEmail",
+        .file = "users.sql",
+        .cmd = ":one",
+        .sql = ConnQuerier.get_user_id_by_email_sql,
+        .params = &.{
+            .{ .name = "email", .sql_type = "text", .nullable = false, .array = false },
+        },
+        .columns = &.{ "id" },
+    },
+    .{
+        .name = "getUserIDsByIPAddress",
+        .sqlc_name = "GetUserIDsByIPAddress",
+        .file = "users.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_user_i_ds_by_ip_address_sql,
+        .params = &.{
+            .{ .name = "ip_address", .sql_type = "inet", .nullable = true, .array = false },
+        },
+        .columns = &.{ "id" },
+    },
+    .{
+        .name = "getUserIDsByRole",
+        .sqlc_name = "GetUserIDsByRole",
+        .file = "users.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_user_i_ds_by_role_sql,
+        .params = &.{
+            .{ .name = "role", .sql_type = "user_role", .nullable = false, .array = false },
+        },
+        .columns = &.{ "id" },
+    },
+    .{
+        .name = "getUserIDsBySalaryRange",
+        .sqlc_name = "GetUserIDsBySalaryRange",
+        .file = "users.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_user_i_ds_by_salary_range_sql,
+        .params = &.{
+            .{ .name = "salary_1", .sql_type = "numeric", .nullable = true, .array = false },
+            .{ .name = "salary_2", .sql_type = "numeric", .nullable = true, .array = false },
+        },
+        .columns = &.{ "id" },
+    },
+    .{
+        .name = "getUserNames",
+        .sqlc_name = "GetUserNames",
+        .file = "users.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_user_names_sql,
+        .params = &.{},
+        .columns = &.{ "name" },
+    },
+    .{
+        .name = "getUsers",
+        .sqlc_name = "GetUsers",
+        .file = "users.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_users_sql,
+        .params = &.{},
+        .columns = &.{ "id", "name", "email", "password", "role", "ip_address", "salary", "notes", "created_at", "updated_at", "archived_at" },
+    },
+};
@@ -0,0 +1,149 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+        
+        conn: T,
+
+        pub fn init(conn: T) Self {
+            return .{ .conn = conn };
+        }
+        
+        const archive_user_sql = 
+            \\UPDATE users SET archived_at = NOW() WHERE id = $1
+        ;
+
+        pub const ArchiveUserParams = struct {
+            id: i32,
+        };
+
+        pub fn archiveUser(self: Self, archive_user_params: ArchiveUserParams) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            _ = try conn.exec(archive_user_sql, .{ 
+                archive_user_params.id,
+            });
+        }
+
+        const find_user_sql = 
+            \\SELECT id, name, email, password, role, ip_address, salary, notes, created_at, updated_at, archived_at FROM users
+            \\WHERE email = $1 LIMIT 1
+        ;
+
+        pub const FindUserResult = union(enum) {
+            user: models.User,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .user => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn findUser(self: Self, ctx: anytype, email: []const u8) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = conn.query(find_user_sql, .{ 
+                email,
+            }) catch |err| {
+                if (conn.err) |_| {
+                    try ctx.handle(.{ .pgerr = conn._err_data orelse unreachable });
+                    return;
+                }
+                return err;
+            };
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+            const row_name = row.get([]const u8, 1);
+            const row_email = row.get([]const u8, 2);
+            const row_password = row.get([]const u8, 3);
+            const row_role = row.get(models.UserRole, 4);
+            const row_ip_address = row.get(?pg.Cidr, 5);
+            const row_salary = row.get(?pg.Numeric, 6);
+            const row_notes = row.get(?[]const u8, 7);
+            const row_created_at = row.get(i64, 8);
+            const row_updated_at = row.get(i64, 9);
+            const row_archived_at = row.get(?i64, 10);
+            try ctx.handle(.{
+                .user = .{
+                    .id = row_id,
+                    .name = row_name,
+                    .email = row_email,
+                    .password = row_password,
+                    .role = row_role,
+                    .ip_address = row_ip_address,
+                    .salary = row_salary,
+                    .notes = row_notes,
+                    .created_at = row_created_at,
+                    .updated_at = row_updated_at,
+                    .archived_at = row_archived_at,
+                },
+            });
+        }
+
+        const list_user_emails_sql = 
+            \\SELECT id, email FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub const ListUserEmailsRow = struct {
+            id: i32,
+            email: []const u8,
+        };
+
+        //  Streams the id and email of every user.
+        pub fn listUserEmails(self: Self, ctx: anytype) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(list_user_emails_sql, .{});
+            defer result.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_email = row.get([]const u8, 1);
+                try ctx.handle(.{
+                    .id = row_id,
+                    .email = row_email,
+                });
+            }
+        }
+
+    };
+}
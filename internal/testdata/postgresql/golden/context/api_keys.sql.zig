@@ -0,0 +1,100 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+        
+        conn: T,
+
+        pub fn init(conn: T) Self {
+            return .{ .conn = conn };
+        }
+        
+        const get_api_key_sql = 
+            \\SELECT id, user_id, secret, fingerprints, created_at, expires_at FROM api_keys
+            \\WHERE id = $1
+        ;
+
+        pub fn getAPIKey(self: Self, ctx: anytype, id: [16]u8) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_api_key_sql, .{ 
+                id,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = row.get([16]u8, 0);
+            const row_user_id = row.get(i32, 1);
+            const row_secret = row.get([]u8, 2);
+            var row_fingerprints = row.get(pg.Iterator([]u8), 3);
+            const row_created_at = row.get(i64, 4);
+            const row_expires_at = row.get(?i64, 5);
+            try ctx.handle(.{
+                .id = row_id,
+                .user_id = row_user_id,
+                .secret = row_secret,
+                .fingerprints = &row_fingerprints,
+                .created_at = row_created_at,
+                .expires_at = row_expires_at,
+            });
+        }
+
+        const list_api_key_secrets_sql = 
+            \\SELECT id, secret, expires_at FROM api_keys
+            \\WHERE user_id = $1
+            \\ORDER BY created_at ASC
+        ;
+
+        pub const ListAPIKeySecretsRow = struct {
+            id: [16]u8,
+            secret: []u8,
+            expires_at: ?i64,
+        };
+
+        pub fn listAPIKeySecrets(self: Self, ctx: anytype, user_id: i32) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(list_api_key_secrets_sql, .{ 
+                user_id,
+            });
+            defer result.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get([16]u8, 0);
+                const row_secret = row.get([]u8, 1);
+                const row_expires_at = row.get(?i64, 2);
+                try ctx.handle(.{
+                    .id = row_id,
+                    .secret = row_secret,
+                    .expires_at = row_expires_at,
+                });
+            }
+        }
+
+    };
+}
@@ -0,0 +1,65 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+
+pub const BillingInvoiceStatus = enum {
+    @"draft",
+    @"paid",
+};
+
+pub const Product = enum {
+    @"laptop",
+    @"desktop",
+    @"mobile",
+    @"tablet",
+};
+
+pub const UserRole = enum {
+    @"admin",
+    @"user",
+};
+
+pub const ApiKey = struct {
+    id: [16]u8,
+    user_id: i32,
+    secret: []u8,
+    fingerprints: *pg.Iterator([]u8),
+    created_at: i64,
+    expires_at: ?i64,
+};
+
+pub const BillingInvoice = struct {
+    id: i32,
+    user_id: i32,
+    status: BillingInvoiceStatus,
+    amount: pg.Numeric,
+    memo: ?[]const u8,
+};
+
+pub const Order = struct {
+    id: i32,
+    order_date: i64,
+    item_ids: *pg.Iterator(i32),
+    products: *pg.Iterator([]const u8),
+    item_quantities: *pg.Iterator(pg.Numeric),
+    shipping_addresses: *pg.Iterator([]const u8),
+    ip_addresses: *pg.Iterator(pg.Cidr),
+    total_amount: pg.Numeric,
+};
+
+pub const User = struct {
+    id: i32,
+    name: []const u8,
+    email: []const u8,
+    password: []const u8,
+    role: UserRole,
+    ip_address: ?pg.Cidr,
+    salary: ?pg.Numeric,
+    notes: ?[]const u8,
+    created_at: i64,
+    updated_at: i64,
+    archived_at: ?i64,
+};
@@ -0,0 +1,215 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+        
+        conn: T,
+
+        pub fn init(conn: T) Self {
+            return .{ .conn = conn };
+        }
+        
+        const create_order_sql = 
+            \\INSERT INTO orders (
+            \\    order_date,
+            \\    item_ids,
+            \\    products,
+            \\    item_quantities,
+            \\    shipping_addresses,
+            \\    ip_addresses,
+            \\    total_amount
+            \\) VALUES (
+            \\    $1,
+            \\    $2,
+            \\    $3,
+            \\    $4,
+            \\    $5,
+            \\    $6,
+            \\    $7
+            \\)
+        ;
+
+        pub const CreateOrderParams = struct {
+            order_date: i64,
+            item_ids: []i32,
+            products: []const models.Product,
+            item_quantities: []f64,
+            shipping_addresses: [][]const u8,
+            ip_addresses: [][]const u8,
+            total_amount: f64,
+        };
+
+        pub fn createOrder(self: Self, create_order_params: CreateOrderParams) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            _ = try conn.exec(create_order_sql, .{ 
+                create_order_params.order_date,
+                create_order_params.item_ids,
+                create_order_params.products,
+                create_order_params.item_quantities,
+                create_order_params.shipping_addresses,
+                create_order_params.ip_addresses,
+                create_order_params.total_amount,
+            });
+        }
+
+        const get_order_by_id_sql = 
+            \\SELECT id, order_date, item_ids, products, item_quantities, shipping_addresses, ip_addresses, total_amount FROM orders
+            \\WHERE id = $1 LIMIT 1
+        ;
+
+        pub fn getOrderByID(self: Self, ctx: anytype, id: i32) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_order_by_id_sql, .{ 
+                id,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+            const row_order_date = row.get(i64, 1);
+            var row_item_ids = row.get(pg.Iterator(i32), 2);
+            var row_products = row.get(pg.Iterator([]const u8), 3);
+            var row_item_quantities = row.get(pg.Iterator(pg.Numeric), 4);
+            var row_shipping_addresses = row.get(pg.Iterator([]const u8), 5);
+            var row_ip_addresses = row.get(pg.Iterator(pg.Cidr), 6);
+            const row_total_amount = row.get(pg.Numeric, 7);
+            try ctx.handle(.{
+                .id = row_id,
+                .order_date = row_order_date,
+                .item_ids = &row_item_ids,
+                .products = &row_products,
+                .item_quantities = &row_item_quantities,
+                .shipping_addresses = &row_shipping_addresses,
+                .ip_addresses = &row_ip_addresses,
+                .total_amount = row_total_amount,
+            });
+        }
+
+        const get_order_partial_sql = 
+            \\SELECT order_date, total_amount, products FROM orders
+        ;
+
+        pub const GetOrderPartialRow = struct {
+            order_date: i64,
+            total_amount: pg.Numeric,
+            products: *pg.Iterator([]const u8),
+        };
+
+        pub fn getOrderPartial(self: Self, ctx: anytype) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_order_partial_sql, .{});
+            defer result.deinit();
+            while (try result.next()) |row| {
+                const row_order_date = row.get(i64, 0);
+                const row_total_amount = row.get(pg.Numeric, 1);
+                var row_products = row.get(pg.Iterator([]const u8), 2);
+                try ctx.handle(.{
+                    .order_date = row_order_date,
+                    .total_amount = row_total_amount,
+                    .products = &row_products,
+                });
+            }
+        }
+
+        const get_order_totals_sql = 
+            \\SELECT total_amount FROM orders
+        ;
+
+        pub fn getOrderTotals(self: Self, ctx: anytype) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_order_totals_sql, .{});
+            defer result.deinit();
+            while (try result.next()) |row| {
+                const row_total_amount = row.get(pg.Numeric, 0);
+                try ctx.handle(row_total_amount);
+            }
+        }
+
+        const get_orders_sql = 
+            \\SELECT id, order_date, item_ids, products, item_quantities, shipping_addresses, ip_addresses, total_amount FROM orders
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getOrders(self: Self, ctx: anytype) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_orders_sql, .{});
+            defer result.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_order_date = row.get(i64, 1);
+                var row_item_ids = row.get(pg.Iterator(i32), 2);
+                var row_products = row.get(pg.Iterator([]const u8), 3);
+                var row_item_quantities = row.get(pg.Iterator(pg.Numeric), 4);
+                var row_shipping_addresses = row.get(pg.Iterator([]const u8), 5);
+                var row_ip_addresses = row.get(pg.Iterator(pg.Cidr), 6);
+                const row_total_amount = row.get(pg.Numeric, 7);
+                try ctx.handle(.{
+                    .id = row_id,
+                    .order_date = row_order_date,
+                    .item_ids = &row_item_ids,
+                    .products = &row_products,
+                    .item_quantities = &row_item_quantities,
+                    .shipping_addresses = &row_shipping_addresses,
+                    .ip_addresses = &row_ip_addresses,
+                    .total_amount = row_total_amount,
+                });
+            }
+        }
+
+    };
+}
@@ -0,0 +1,326 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+        
+        conn: T,
+
+        pub fn init(conn: T) Self {
+            return .{ .conn = conn };
+        }
+        
+        const create_user_sql = 
+            \\INSERT INTO users (
+            \\    name, 
+            \\    email, 
+            \\    password, 
+            \\    role, 
+            \\    ip_address,
+            \\    salary,
+            \\    created_at,
+            \\    updated_at
+            \\) VALUES (
+            \\    $1, $2, $3, $4, $5, $6, NOW(), NOW()
+            \\)
+        ;
+
+        pub const CreateUserParams = struct {
+            name: []const u8,
+            email: []const u8,
+            password: []const u8,
+            role: models.UserRole,
+            ip_address: ?[]const u8 = null,
+            salary: ?f64 = null,
+        };
+
+        pub fn createUser(self: Self, create_user_params: CreateUserParams) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            _ = try conn.exec(create_user_sql, .{ 
+                create_user_params.name,
+                create_user_params.email,
+                create_user_params.password,
+                create_user_params.role,
+                create_user_params.ip_address,
+                create_user_params.salary,
+            });
+        }
+
+        const get_user_sql = 
+            \\SELECT id, name, email, password, role, ip_address, salary, notes, created_at, updated_at, archived_at FROM users
+            \\WHERE id = $1 LIMIT 1
+        ;
+
+        pub fn getUser(self: Self, ctx: anytype, id: i32) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_sql, .{ 
+                id,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+            const row_name = row.get([]const u8, 1);
+            const row_email = row.get([]const u8, 2);
+            const row_password = row.get([]const u8, 3);
+            const row_role = row.get(models.UserRole, 4);
+            const row_ip_address = row.get(?pg.Cidr, 5);
+            const row_salary = row.get(?pg.Numeric, 6);
+            const row_notes = row.get(?[]const u8, 7);
+            const row_created_at = row.get(i64, 8);
+            const row_updated_at = row.get(i64, 9);
+            const row_archived_at = row.get(?i64, 10);
+            try ctx.handle(.{
+                .id = row_id,
+                .name = row_name,
+                .email = row_email,
+                .password = row_password,
+                .role = row_role,
+                .ip_address = row_ip_address,
+                .salary = row_salary,
+                .notes = row_notes,
+                .created_at = row_created_at,
+                .updated_at = row_updated_at,
+                .archived_at = row_archived_at,
+            });
+        }
+
+        const get_user_emails_sql = 
+            \\SELECT id, email FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetUserEmailsRow = struct {
+            id: i32,
+            email: []const u8,
+        };
+
+        pub fn getUserEmails(self: Self, ctx: anytype) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_emails_sql, .{});
+            defer result.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_email = row.get([]const u8, 1);
+                try ctx.handle(.{
+                    .id = row_id,
+                    .email = row_email,
+                });
+            }
+        }
+
+        const get_user_id_by_email_sql = 
+            \\SELECT id FROM users
+            \\WHERE email = $1 LIMIT 1
+        ;
+
+        pub fn getUserIDByEmail(self: Self, ctx: anytype, email: []const u8) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_id_by_email_sql, .{ 
+                email,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+            try ctx.handle(row_id);
+        }
+
+        const get_user_i_ds_by_ip_address_sql = 
+            \\SELECT id FROM users
+            \\WHERE ip_address = $1
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getUserIDsByIPAddress(self: Self, ctx: anytype, ip_address: []const u8) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_i_ds_by_ip_address_sql, .{ 
+                ip_address,
+            });
+            defer result.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                try ctx.handle(row_id);
+            }
+        }
+
+        const get_user_i_ds_by_role_sql = 
+            \\SELECT id FROM users
+            \\WHERE role = $1
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getUserIDsByRole(self: Self, ctx: anytype, role: models.UserRole) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_i_ds_by_role_sql, .{ 
+                role,
+            });
+            defer result.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                try ctx.handle(row_id);
+            }
+        }
+
+        const get_user_i_ds_by_salary_range_sql = 
+            \\SELECT id FROM users
+            \\WHERE salary >= $1 AND salary <= $2
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getUserIDsBySalaryRange(self: Self, ctx: anytype, salary_1: f64, salary_2: f64) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_i_ds_by_salary_range_sql, .{ 
+                salary_1,
+                salary_2,
+            });
+            defer result.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                try ctx.handle(row_id);
+            }
+        }
+
+        const get_user_names_sql = 
+            \\SELECT name FROM users
+        ;
+
+        pub fn getUserNames(self: Self, ctx: anytype) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_names_sql, .{});
+            defer result.deinit();
+            while (try result.next()) |row| {
+                const row_name = row.get([]const u8, 0);
+                try ctx.handle(row_name);
+            }
+        }
+
+        const get_users_sql = 
+            \\SELECT id, name, email, password, role, ip_address, salary, notes, created_at, updated_at, archived_at FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getUsers(self: Self, ctx: anytype) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_users_sql, .{});
+            defer result.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_name = row.get([]const u8, 1);
+                const row_email = row.get([]const u8, 2);
+                const row_password = row.get([]const u8, 3);
+                const row_role = row.get(models.UserRole, 4);
+                const row_ip_address = row.get(?pg.Cidr, 5);
+                const row_salary = row.get(?pg.Numeric, 6);
+                const row_notes = row.get(?[]const u8, 7);
+                const row_created_at = row.get(i64, 8);
+                const row_updated_at = row.get(i64, 9);
+                const row_archived_at = row.get(?i64, 10);
+                try ctx.handle(.{
+                    .id = row_id,
+                    .name = row_name,
+                    .email = row_email,
+                    .password = row_password,
+                    .role = row_role,
+                    .ip_address = row_ip_address,
+                    .salary = row_salary,
+                    .notes = row_notes,
+                    .created_at = row_created_at,
+                    .updated_at = row_updated_at,
+                    .archived_at = row_archived_at,
+                });
+            }
+        }
+
+    };
+}
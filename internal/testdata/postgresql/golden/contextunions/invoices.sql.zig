@@ -0,0 +1,173 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+        
+        conn: T,
+
+        pub fn init(conn: T) Self {
+            return .{ .conn = conn };
+        }
+        
+        const create_invoice_sql = 
+            \\INSERT INTO billing.invoices (user_id, status, amount, memo) VALUES ($1, $2, $3, $4)
+        ;
+
+        pub const CreateInvoiceParams = struct {
+            user_id: i32,
+            status: models.BillingInvoiceStatus,
+            amount: f64,
+            memo: ?[]const u8 = null,
+        };
+
+        pub const CreateInvoiceResult = union(enum) {
+            ok: void,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .ok => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn createInvoice(self: Self, ctx: anytype, create_invoice_params: CreateInvoiceParams) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            _ = conn.exec(create_invoice_sql, .{ 
+                create_invoice_params.user_id,
+                create_invoice_params.status,
+                create_invoice_params.amount,
+                create_invoice_params.memo,
+            }) catch |err| {
+                if (conn.err) |_| {
+                    try ctx.handle(.{ .pgerr = conn._err_data orelse unreachable });
+                    return;
+                }
+                return err;
+            };
+            try ctx.handle(.{ .ok = undefined });
+            return;
+        }
+
+        const get_invoice_status_sql = 
+            \\SELECT status FROM billing.invoices
+            \\WHERE id = $1
+        ;
+
+        pub const GetInvoiceStatusResult = union(enum) {
+            status: models.BillingInvoiceStatus,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .status => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getInvoiceStatus(self: Self, ctx: anytype, id: i32) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = conn.query(get_invoice_status_sql, .{ 
+                id,
+            }) catch |err| {
+                if (conn.err) |_| {
+                    try ctx.handle(.{ .pgerr = conn._err_data orelse unreachable });
+                    return;
+                }
+                return err;
+            };
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_status = row.get(models.BillingInvoiceStatus, 0);
+            try ctx.handle(.{
+                .status = row_status,
+            });
+        }
+
+        const get_invoices_sql = 
+            \\SELECT id, user_id, status, amount, memo FROM billing.invoices
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetInvoicesResult = union(enum) {
+            billing_invoice: models.BillingInvoice,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .billing_invoice => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getInvoices(self: Self, ctx: anytype) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = conn.query(get_invoices_sql, .{}) catch |err| {
+                if (conn.err) |_| {
+                    try ctx.handle(.{ .pgerr = conn._err_data orelse unreachable });
+                    return;
+                }
+                return err;
+            };
+            defer result.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_user_id = row.get(i32, 1);
+                const row_status = row.get(models.BillingInvoiceStatus, 2);
+                const row_amount = row.get(pg.Numeric, 3);
+                const row_memo = row.get(?[]const u8, 4);
+                try ctx.handle(.{
+                    .billing_invoice = .{
+                        .id = row_id,
+                        .user_id = row_user_id,
+                        .status = row_status,
+                        .amount = row_amount,
+                        .memo = row_memo,
+                    },
+                });
+            }
+        }
+
+    };
+}
@@ -0,0 +1,309 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+
+pub const BillingInvoiceStatus = enum {
+    @"draft",
+    @"paid",
+};
+
+pub const Product = enum {
+    @"laptop",
+    @"desktop",
+    @"mobile",
+    @"tablet",
+};
+
+pub const UserRole = enum {
+    @"admin",
+    @"user",
+};
+
+pub const ApiKey = struct {
+    __allocator: Allocator,
+
+    id: [16]u8,
+    user_id: i32,
+    secret: []u8,
+    fingerprints: [][]u8,
+    created_at: i64,
+    expires_at: ?i64 = null,
+
+    pub fn deinit(self: *const ApiKey) void {
+        self.__allocator.free(self.id);
+        self.__allocator.free(self.secret);
+        for (self.fingerprints) |item| {
+            self.__allocator.free(item);
+        }
+        self.__allocator.free(self.fingerprints);
+    }
+
+    // Returns a deep copy of the struct owned by allocator, to be freed with
+    // deinit.
+    pub fn clone(self: @This(), allocator: Allocator) !@This() {
+        return deep.clone(allocator, self);
+    }
+
+    // Reports whether all fields of the structs hold equal values.
+    pub fn eql(self: @This(), other: @This()) bool {
+        return deep.eql(self, other);
+    }
+
+    // Hashes the values of all fields, consistent with eql.
+    pub fn hash(self: @This()) u64 {
+        var hasher = std.hash.Wyhash.init(0);
+        deep.hash(&hasher, self);
+        return hasher.final();
+    }
+};
+
+pub const BillingInvoice = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    user_id: i32,
+    status: BillingInvoiceStatus,
+    amount: pg.Numeric,
+    memo: ?[]const u8 = null,
+
+    pub fn deinit(self: *const BillingInvoice) void {
+        self.__allocator.free(self.amount.digits);
+        if (self.memo) |field| {
+            self.__allocator.free(field);
+        }
+    }
+
+    // Returns a deep copy of the struct owned by allocator, to be freed with
+    // deinit.
+    pub fn clone(self: @This(), allocator: Allocator) !@This() {
+        return deep.clone(allocator, self);
+    }
+
+    // Reports whether all fields of the structs hold equal values.
+    pub fn eql(self: @This(), other: @This()) bool {
+        return deep.eql(self, other);
+    }
+
+    // Hashes the values of all fields, consistent with eql.
+    pub fn hash(self: @This()) u64 {
+        var hasher = std.hash.Wyhash.init(0);
+        deep.hash(&hasher, self);
+        return hasher.final();
+    }
+};
+
+pub const Order = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    order_date: i64,
+    item_ids: []i32,
+    products: []const Product,
+    item_quantities: []pg.Numeric,
+    shipping_addresses: [][]const u8,
+    ip_addresses: []pg.Cidr,
+    total_amount: pg.Numeric,
+
+    pub fn deinit(self: *const Order) void {
+        self.__allocator.free(self.item_ids);
+        self.__allocator.free(self.products);
+        for (self.item_quantities) |item| {
+            self.__allocator.free(item.digits);
+        }
+        self.__allocator.free(self.item_quantities);
+        for (self.shipping_addresses) |item| {
+            self.__allocator.free(item);
+        }
+        self.__allocator.free(self.shipping_addresses);
+        for (self.ip_addresses) |item| {
+            self.__allocator.free(item.address);
+        }
+        self.__allocator.free(self.ip_addresses);
+        self.__allocator.free(self.total_amount.digits);
+    }
+
+    // Returns a deep copy of the struct owned by allocator, to be freed with
+    // deinit.
+    pub fn clone(self: @This(), allocator: Allocator) !@This() {
+        return deep.clone(allocator, self);
+    }
+
+    // Reports whether all fields of the structs hold equal values.
+    pub fn eql(self: @This(), other: @This()) bool {
+        return deep.eql(self, other);
+    }
+
+    // Hashes the values of all fields, consistent with eql.
+    pub fn hash(self: @This()) u64 {
+        var hasher = std.hash.Wyhash.init(0);
+        deep.hash(&hasher, self);
+        return hasher.final();
+    }
+};
+
+pub const User = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    name: []const u8,
+    email: []const u8,
+    password: []const u8,
+    role: UserRole,
+    ip_address: ?pg.Cidr = null,
+    salary: ?pg.Numeric = null,
+    notes: ?[]const u8 = null,
+    created_at: i64,
+    updated_at: i64,
+    archived_at: ?i64 = null,
+
+    pub fn deinit(self: *const User) void {
+        self.__allocator.free(self.name);
+        self.__allocator.free(self.email);
+        self.__allocator.free(self.password);
+        if (self.ip_address) |field| {
+            self.__allocator.free(field.address);
+        }
+        if (self.salary) |field| {
+            self.__allocator.free(field.digits);
+        }
+        if (self.notes) |field| {
+            self.__allocator.free(field);
+        }
+    }
+
+    // Returns a deep copy of the struct owned by allocator, to be freed with
+    // deinit.
+    pub fn clone(self: @This(), allocator: Allocator) !@This() {
+        return deep.clone(allocator, self);
+    }
+
+    // Reports whether all fields of the structs hold equal values.
+    pub fn eql(self: @This(), other: @This()) bool {
+        return deep.eql(self, other);
+    }
+
+    // Hashes the values of all fields, consistent with eql.
+    pub fn hash(self: @This()) u64 {
+        var hasher = std.hash.Wyhash.init(0);
+        deep.hash(&hasher, self);
+        return hasher.final();
+    }
+};
+
+// Walks the fields of generated structs for the clone, eql and hash methods.
+// Slices are followed and the internal allocator field is skipped.
+const deep = struct {
+    fn clone(allocator: Allocator, value: anytype) !@TypeOf(value) {
+        const T = @TypeOf(value);
+        switch (@typeInfo(T)) {
+            .optional => return if (value) |v| try clone(allocator, v) else null,
+            .pointer => |ptr| {
+                switch (@typeInfo(ptr.child)) {
+                    .optional, .pointer, .@"struct" => {},
+                    else => return allocator.dupe(ptr.child, value),
+                }
+                const out = try allocator.alloc(ptr.child, value.len);
+                errdefer allocator.free(out);
+                var cloned: usize = 0;
+                errdefer for (out[0..cloned]) |item| free(allocator, item);
+                for (value) |item| {
+                    out[cloned] = try clone(allocator, item);
+                    cloned += 1;
+                }
+                return out;
+            },
+            .@"struct" => |info| {
+                var out = value;
+                var cloned: usize = 0;
+                errdefer inline for (info.fields, 0..) |field, i| {
+                    if (i < cloned and !comptime isAllocator(field.name)) {
+                        free(allocator, @field(out, field.name));
+                    }
+                }
+                inline for (info.fields) |field| {
+                    if (comptime isAllocator(field.name)) {
+                        out.__allocator = allocator;
+                    } else {
+                        @field(out, field.name) = try clone(allocator, @field(value, field.name));
+                    }
+                    cloned += 1;
+                }
+                return out;
+            },
+            else => return value,
+        }
+    }
+
+    fn free(allocator: Allocator, value: anytype) void {
+        switch (@typeInfo(@TypeOf(value))) {
+            .optional => if (value) |v| free(allocator, v),
+            .pointer => {
+                for (value) |item| free(allocator, item);
+                allocator.free(value);
+            },
+            .@"struct" => |info| inline for (info.fields) |field| {
+                if (!comptime isAllocator(field.name)) {
+                    free(allocator, @field(value, field.name));
+                }
+            },
+            else => {},
+        }
+    }
+
+    fn eql(a: anytype, b: @TypeOf(a)) bool {
+        switch (@typeInfo(@TypeOf(a))) {
+            .optional => {
+                const x = a orelse return b == null;
+                const y = b orelse return false;
+                return eql(x, y);
+            },
+            .pointer => {
+                if (a.len != b.len) {
+                    return false;
+                }
+                for (a, b) |x, y| {
+                    if (!eql(x, y)) {
+                        return false;
+                    }
+                }
+                return true;
+            },
+            .array => return std.mem.eql(@typeInfo(@TypeOf(a)).array.child, &a, &b),
+            .@"struct" => |info| {
+                inline for (info.fields) |field| {
+                    if (!comptime isAllocator(field.name) and !eql(@field(a, field.name), @field(b, field.name))) {
+                        return false;
+                    }
+                }
+                return true;
+            },
+            else => return a == b,
+        }
+    }
+
+    fn hash(hasher: *std.hash.Wyhash, value: anytype) void {
+        switch (@typeInfo(@TypeOf(value))) {
+            .optional => {
+                hasher.update(&[_]u8{@intFromBool(value != null)});
+                if (value) |v| hash(hasher, v);
+            },
+            .pointer => {
+                hasher.update(std.mem.asBytes(&value.len));
+                for (value) |item| hash(hasher, item);
+            },
+            .@"struct" => |info| inline for (info.fields) |field| {
+                if (!comptime isAllocator(field.name)) {
+                    hash(hasher, @field(value, field.name));
+                }
+            },
+            else => hasher.update(std.mem.asBytes(&value)),
+        }
+    }
+
+    fn isAllocator(comptime name: []const u8) bool {
+        return std.mem.eql(u8, name, "__allocator");
+    }
+};
@@ -0,0 +1,520 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+        
+        const create_order_sql = 
+            \\INSERT INTO orders (
+            \\    order_date,
+            \\    item_ids,
+            \\    products,
+            \\    item_quantities,
+            \\    shipping_addresses,
+            \\    ip_addresses,
+            \\    total_amount
+            \\) VALUES (
+            \\    $1,
+            \\    $2,
+            \\    $3,
+            \\    $4,
+            \\    $5,
+            \\    $6,
+            \\    $7
+            \\)
+        ;
+
+        pub const CreateOrderParams = struct {
+            order_date: i64,
+            item_ids: []i32,
+            products: []const models.Product,
+            item_quantities: []f64,
+            shipping_addresses: [][]const u8,
+            ip_addresses: [][]const u8,
+            total_amount: f64,
+        };
+
+        pub fn createOrder(self: Self, create_order_params: CreateOrderParams) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            _ = try conn.exec(create_order_sql, .{ 
+                create_order_params.order_date,
+                create_order_params.item_ids,
+                create_order_params.products,
+                create_order_params.item_quantities,
+                create_order_params.shipping_addresses,
+                create_order_params.ip_addresses,
+                create_order_params.total_amount,
+            });
+        }
+
+        const get_order_by_id_sql = 
+            \\SELECT id, order_date, item_ids, products, item_quantities, shipping_addresses, ip_addresses, total_amount FROM orders
+            \\WHERE id = $1 LIMIT 1
+        ;
+
+        pub fn getOrderByID(self: Self, id: i32) !models.Order {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_order_by_id_sql, .{ 
+                id,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+            const row_order_date = row.get(i64, 1);
+            var row_item_ids = std.ArrayList(i32).init(allocator);
+            defer row_item_ids.deinit();
+            var row_item_ids_iter = row.get(pg.Iterator(i32), 2);
+            while (row_item_ids_iter.next()) |item| {
+                try row_item_ids.append(item);
+            }
+            var row_products = std.ArrayList(models.Product).init(allocator);
+            defer row_products.deinit();
+            var row_products_iter = row.get(pg.Iterator([]const u8), 3);
+            while (row_products_iter.next()) |item| {
+                try row_products.append(std.meta.stringToEnum(models.Product, item) orelse unreachable);
+            }
+            var row_item_quantities = std.ArrayList(pg.Numeric).init(allocator);
+            defer row_item_quantities.deinit();
+            var row_item_quantities_iter = row.get(pg.Iterator(pg.Numeric), 4);
+            while (row_item_quantities_iter.next()) |item| {
+                const digits = try allocator.dupe(u8, item.digits);
+                errdefer allocator.free(digits);
+                try row_item_quantities.append(pg.Numeric{
+                    .number_of_digits = item.number_of_digits,
+                    .weight = item.weight,
+                    .sign = item.sign,
+                    .scale = item.scale,
+                    .digits = digits,
+                });
+            }
+            var row_shipping_addresses = std.ArrayList([]const u8).init(allocator);
+            defer row_shipping_addresses.deinit();
+            var row_shipping_addresses_iter = row.get(pg.Iterator([]const u8), 5);
+            while (row_shipping_addresses_iter.next()) |item| {
+                const value = try allocator.dupe(u8, item);
+                errdefer allocator.free(value);
+                try row_shipping_addresses.append(value);
+            }
+            var row_ip_addresses = std.ArrayList(pg.Cidr).init(allocator);
+            defer row_ip_addresses.deinit();
+            var row_ip_addresses_iter = row.get(pg.Iterator(pg.Cidr), 6);
+            while (row_ip_addresses_iter.next()) |item| {
+                const address = try allocator.dupe(u8, item.address);
+                errdefer allocator.free(address);
+                try row_ip_addresses.append(pg.Cidr{
+                    .address = address,
+                    .netmask = item.netmask,
+                    .family = item.family,
+                });
+            }
+            const total_amount_numeric = row.get(pg.Numeric, 7);const row_total_amount = pg.Numeric{
+                .number_of_digits = total_amount_numeric.number_of_digits,
+                .weight = total_amount_numeric.weight,
+                .sign = total_amount_numeric.sign,
+                .scale = total_amount_numeric.scale,
+                .digits = try allocator.dupe(u8, total_amount_numeric.digits),
+            };
+            errdefer allocator.free(row_total_amount.digits);
+
+            return .{
+                .__allocator = allocator,
+                .id = row_id,
+                .order_date = row_order_date,
+                .item_ids = try row_item_ids.toOwnedSlice(),
+                .products = try row_products.toOwnedSlice(),
+                .item_quantities = try row_item_quantities.toOwnedSlice(),
+                .shipping_addresses = try row_shipping_addresses.toOwnedSlice(),
+                .ip_addresses = try row_ip_addresses.toOwnedSlice(),
+                .total_amount = row_total_amount,
+            };
+        }
+
+        const get_order_partial_sql = 
+            \\SELECT order_date, total_amount, products FROM orders
+        ;
+
+        pub const GetOrderPartialRow = struct {
+            __allocator: Allocator,
+
+            order_date: i64,
+            total_amount: pg.Numeric,
+            products: []const models.Product,
+
+            pub fn deinit(self: *const GetOrderPartialRow) void {
+                self.__allocator.free(self.total_amount.digits);
+            }
+
+            // Returns a deep copy of the struct owned by allocator, to be freed with
+            // deinit.
+            pub fn clone(self: @This(), allocator: Allocator) !@This() {
+                return deep.clone(allocator, self);
+            }
+
+            // Reports whether all fields of the structs hold equal values.
+            pub fn eql(self: @This(), other: @This()) bool {
+                return deep.eql(self, other);
+            }
+
+            // Hashes the values of all fields, consistent with eql.
+            pub fn hash(self: @This()) u64 {
+                var hasher = std.hash.Wyhash.init(0);
+                deep.hash(&hasher, self);
+                return hasher.final();
+            }
+        };
+
+        pub fn getOrderPartial(self: Self) ![]GetOrderPartialRow {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_order_partial_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(GetOrderPartialRow).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_order_date = row.get(i64, 0);
+                const total_amount_numeric = row.get(pg.Numeric, 1);const row_total_amount = pg.Numeric{
+                    .number_of_digits = total_amount_numeric.number_of_digits,
+                    .weight = total_amount_numeric.weight,
+                    .sign = total_amount_numeric.sign,
+                    .scale = total_amount_numeric.scale,
+                    .digits = try allocator.dupe(u8, total_amount_numeric.digits),
+                };
+                errdefer allocator.free(row_total_amount.digits);
+                var row_products = std.ArrayList(models.Product).init(allocator);
+                defer row_products.deinit();
+                var row_products_iter = row.get(pg.Iterator([]const u8), 2);
+                while (row_products_iter.next()) |item| {
+                    try row_products.append(std.meta.stringToEnum(models.Product, item) orelse unreachable);
+                }
+                try out.append(.{
+                    .__allocator = allocator,
+                    .order_date = row_order_date,
+                    .total_amount = row_total_amount,
+                    .products = try row_products.toOwnedSlice(),
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getOrderPartial along with the slice
+        // holding them.
+        pub fn freeGetOrderPartial(self: Self, rows: []const GetOrderPartialRow) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+        const get_order_totals_sql = 
+            \\SELECT total_amount FROM orders
+        ;
+
+        pub fn getOrderTotals(self: Self) ![]pg.Numeric {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_order_totals_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(pg.Numeric).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const total_amount_numeric = row.get(pg.Numeric, 0);const row_total_amount = pg.Numeric{
+                    .number_of_digits = total_amount_numeric.number_of_digits,
+                    .weight = total_amount_numeric.weight,
+                    .sign = total_amount_numeric.sign,
+                    .scale = total_amount_numeric.scale,
+                    .digits = try allocator.dupe(u8, total_amount_numeric.digits),
+                };
+                errdefer allocator.free(row_total_amount.digits);
+                try out.append(row_total_amount);
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getOrderTotals along with the slice
+        // holding them.
+        pub fn freeGetOrderTotals(self: Self, rows: []const pg.Numeric) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                allocator.free(row.digits);
+            }
+            allocator.free(rows);
+        }
+
+        const get_orders_sql = 
+            \\SELECT id, order_date, item_ids, products, item_quantities, shipping_addresses, ip_addresses, total_amount FROM orders
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getOrders(self: Self) ![]models.Order {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_orders_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(models.Order).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_order_date = row.get(i64, 1);
+                var row_item_ids = std.ArrayList(i32).init(allocator);
+                defer row_item_ids.deinit();
+                var row_item_ids_iter = row.get(pg.Iterator(i32), 2);
+                while (row_item_ids_iter.next()) |item| {
+                    try row_item_ids.append(item);
+                }
+                var row_products = std.ArrayList(models.Product).init(allocator);
+                defer row_products.deinit();
+                var row_products_iter = row.get(pg.Iterator([]const u8), 3);
+                while (row_products_iter.next()) |item| {
+                    try row_products.append(std.meta.stringToEnum(models.Product, item) orelse unreachable);
+                }
+                var row_item_quantities = std.ArrayList(pg.Numeric).init(allocator);
+                defer row_item_quantities.deinit();
+                var row_item_quantities_iter = row.get(pg.Iterator(pg.Numeric), 4);
+                while (row_item_quantities_iter.next()) |item| {
+                    const digits = try allocator.dupe(u8, item.digits);
+                    errdefer allocator.free(digits);
+                    try row_item_quantities.append(pg.Numeric{
+                        .number_of_digits = item.number_of_digits,
+                        .weight = item.weight,
+                        .sign = item.sign,
+                        .scale = item.scale,
+                        .digits = digits,
+                    });
+                }
+                var row_shipping_addresses = std.ArrayList([]const u8).init(allocator);
+                defer row_shipping_addresses.deinit();
+                var row_shipping_addresses_iter = row.get(pg.Iterator([]const u8), 5);
+                while (row_shipping_addresses_iter.next()) |item| {
+                    const value = try allocator.dupe(u8, item);
+                    errdefer allocator.free(value);
+                    try row_shipping_addresses.append(value);
+                }
+                var row_ip_addresses = std.ArrayList(pg.Cidr).init(allocator);
+                defer row_ip_addresses.deinit();
+                var row_ip_addresses_iter = row.get(pg.Iterator(pg.Cidr), 6);
+                while (row_ip_addresses_iter.next()) |item| {
+                    const address = try allocator.dupe(u8, item.address);
+                    errdefer allocator.free(address);
+                    try row_ip_addresses.append(pg.Cidr{
+                        .address = address,
+                        .netmask = item.netmask,
+                        .family = item.family,
+                    });
+                }
+                const total_amount_numeric = row.get(pg.Numeric, 7);const row_total_amount = pg.Numeric{
+                    .number_of_digits = total_amount_numeric.number_of_digits,
+                    .weight = total_amount_numeric.weight,
+                    .sign = total_amount_numeric.sign,
+                    .scale = total_amount_numeric.scale,
+                    .digits = try allocator.dupe(u8, total_amount_numeric.digits),
+                };
+                errdefer allocator.free(row_total_amount.digits);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .order_date = row_order_date,
+                    .item_ids = try row_item_ids.toOwnedSlice(),
+                    .products = try row_products.toOwnedSlice(),
+                    .item_quantities = try row_item_quantities.toOwnedSlice(),
+                    .shipping_addresses = try row_shipping_addresses.toOwnedSlice(),
+                    .ip_addresses = try row_ip_addresses.toOwnedSlice(),
+                    .total_amount = row_total_amount,
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getOrders along with the slice
+        // holding them.
+        pub fn freeGetOrders(self: Self, rows: []const models.Order) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
+
+// Walks the fields of generated structs for the clone, eql and hash methods.
+// Slices are followed and the internal allocator field is skipped.
+const deep = struct {
+    fn clone(allocator: Allocator, value: anytype) !@TypeOf(value) {
+        const T = @TypeOf(value);
+        switch (@typeInfo(T)) {
+            .optional => return if (value) |v| try clone(allocator, v) else null,
+            .pointer => |ptr| {
+                switch (@typeInfo(ptr.child)) {
+                    .optional, .pointer, .@"struct" => {},
+                    else => return allocator.dupe(ptr.child, value),
+                }
+                const out = try allocator.alloc(ptr.child, value.len);
+                errdefer allocator.free(out);
+                var cloned: usize = 0;
+                errdefer for (out[0..cloned]) |item| free(allocator, item);
+                for (value) |item| {
+                    out[cloned] = try clone(allocator, item);
+                    cloned += 1;
+                }
+                return out;
+            },
+            .@"struct" => |info| {
+                var out = value;
+                var cloned: usize = 0;
+                errdefer inline for (info.fields, 0..) |field, i| {
+                    if (i < cloned and !comptime isAllocator(field.name)) {
+                        free(allocator, @field(out, field.name));
+                    }
+                }
+                inline for (info.fields) |field| {
+                    if (comptime isAllocator(field.name)) {
+                        out.__allocator = allocator;
+                    } else {
+                        @field(out, field.name) = try clone(allocator, @field(value, field.name));
+                    }
+                    cloned += 1;
+                }
+                return out;
+            },
+            else => return value,
+        }
+    }
+
+    fn free(allocator: Allocator, value: anytype) void {
+        switch (@typeInfo(@TypeOf(value))) {
+            .optional => if (value) |v| free(allocator, v),
+            .pointer => {
+                for (value) |item| free(allocator, item);
+                allocator.free(value);
+            },
+            .@"struct" => |info| inline for (info.fields) |field| {
+                if (!comptime isAllocator(field.name)) {
+                    free(allocator, @field(value, field.name));
+                }
+            },
+            else => {},
+        }
+    }
+
+    fn eql(a: anytype, b: @TypeOf(a)) bool {
+        switch (@typeInfo(@TypeOf(a))) {
+            .optional => {
+                const x = a orelse return b == null;
+                const y = b orelse return false;
+                return eql(x, y);
+            },
+            .pointer => {
+                if (a.len != b.len) {
+                    return false;
+                }
+                for (a, b) |x, y| {
+                    if (!eql(x, y)) {
+                        return false;
+                    }
+                }
+                return true;
+            },
+            .array => return std.mem.eql(@typeInfo(@TypeOf(a)).array.child, &a, &b),
+            .@"struct" => |info| {
+                inline for (info.fields) |field| {
+                    if (!comptime isAllocator(field.name) and !eql(@field(a, field.name), @field(b, field.name))) {
+                        return false;
+                    }
+                }
+                return true;
+            },
+            else => return a == b,
+        }
+    }
+
+    fn hash(hasher: *std.hash.Wyhash, value: anytype) void {
+        switch (@typeInfo(@TypeOf(value))) {
+            .optional => {
+                hasher.update(&[_]u8{@intFromBool(value != null)});
+                if (value) |v| hash(hasher, v);
+            },
+            .pointer => {
+                hasher.update(std.mem.asBytes(&value.len));
+                for (value) |item| hash(hasher, item);
+            },
+            .@"struct" => |info| inline for (info.fields) |field| {
+                if (!comptime isAllocator(field.name)) {
+                    hash(hasher, @field(value, field.name));
+                }
+            },
+            else => hasher.update(std.mem.asBytes(&value)),
+        }
+    }
+
+    fn isAllocator(comptime name: []const u8) bool {
+        return std.mem.eql(u8, name, "__allocator");
+    }
+};
@@ -0,0 +1,138 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+        
+        const get_api_key_sql = 
+            \\SELECT id, user_id, secret, fingerprints, created_at, expires_at FROM api_keys
+            \\WHERE id = $1
+        ;
+
+        pub fn getAPIKey(self: Self, id: [16]u8) !models.ApiKey {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_api_key_sql, .{ 
+                id,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = try allocator.dupe([16]u8, row.get([16]u8, 0));
+            errdefer allocator.free(row_id);
+            const row_user_id = row.get(i32, 1);
+            const row_secret = try allocator.dupe(u8, row.get([]u8, 2));
+            errdefer allocator.free(row_secret);
+            var row_fingerprints = std.ArrayList([]u8).init(allocator);
+            defer row_fingerprints.deinit();
+            var row_fingerprints_iter = row.get(pg.Iterator([]u8), 3);
+            while (row_fingerprints_iter.next()) |item| {
+                const value = try allocator.dupe(u8, item);
+                errdefer allocator.free(value);
+                try row_fingerprints.append(value);
+            }
+            const row_created_at = row.get(i64, 4);
+            const row_expires_at = row.get(?i64, 5);
+
+            return .{
+                .__allocator = allocator,
+                .id = row_id,
+                .user_id = row_user_id,
+                .secret = row_secret,
+                .fingerprints = try row_fingerprints.toOwnedSlice(),
+                .created_at = row_created_at,
+                .expires_at = row_expires_at,
+            };
+        }
+
+        const list_api_key_secrets_sql = 
+            \\SELECT id, secret, expires_at FROM api_keys
+            \\WHERE user_id = $1
+            \\ORDER BY created_at ASC
+        ;
+
+        pub const ListAPIKeySecretsRow = struct {
+            __allocator: Allocator,
+
+            id: [16]u8,
+            secret: []u8,
+            expires_at: ?i64 = null,
+
+            pub fn deinit(self: *const ListAPIKeySecretsRow) void {
+                self.__allocator.free(self.id);
+                self.__allocator.free(self.secret);
+            }
+        };
+
+        pub fn listAPIKeySecrets(self: Self, user_id: i32) ![]ListAPIKeySecretsRow {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(list_api_key_secrets_sql, .{ 
+                user_id,
+            });
+            defer result.deinit();
+            var out = std.ArrayList(ListAPIKeySecretsRow).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = try allocator.dupe([16]u8, row.get([16]u8, 0));
+                errdefer allocator.free(row_id);
+                const row_secret = try allocator.dupe(u8, row.get([]u8, 1));
+                errdefer allocator.free(row_secret);
+                const row_expires_at = row.get(?i64, 2);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .secret = row_secret,
+                    .expires_at = row_expires_at,
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by listAPIKeySecrets along with the slice
+        // holding them.
+        pub fn freeListAPIKeySecrets(self: Self, rows: []const ListAPIKeySecretsRow) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
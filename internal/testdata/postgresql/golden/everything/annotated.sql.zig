@@ -0,0 +1,742 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+const catalog = @import("queries.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn, NoHooks);
+pub const PoolQuerier = Querier(*pg.Pool, NoHooks);
+
+pub fn Querier(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(T, Hooks) {
+            return QuerierInterface(T, Hooks).init(Self, self);
+        }
+        
+        const archive_user_sql = 
+            \\UPDATE users SET archived_at = NOW() WHERE id = $1
+        ;
+
+        pub const ArchiveUserParams = struct {
+            id: i32,
+
+            pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+                _ = fmt;
+                _ = options;
+                try writer.writeAll("ArchiveUserParams{ .id = ");
+                try debug_format.any(writer, self.id);
+                try writer.writeAll(" }");
+            }
+        };
+
+        pub const ArchiveUserResult = union(enum) {
+            ok: void,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .ok => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn archiveUser(self: Self, archive_user_params: ArchiveUserParams) !ArchiveUserResult {
+            const hook = QueryHook(Hooks).begin("archiveUser", archive_user_sql);
+            const result = self.archiveUserUnhooked(archive_user_params);
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(0, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn archiveUserUnhooked(self: Self, archive_user_params: ArchiveUserParams) !ArchiveUserResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.archiveUserUntimed(conn, archive_user_params);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .ok => {},
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn archiveUserUntimed(self: Self, conn: *pg.Conn, archive_user_params: ArchiveUserParams) !ArchiveUserResult {
+            const allocator = self.allocator;
+            _ = conn.exec(archive_user_sql, .{ 
+                archive_user_params.id,
+            }) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            return .{ .ok = undefined };
+        }
+
+        const find_user_sql = 
+            \\SELECT id, name, email, password, role, ip_address, salary, notes, created_at, updated_at, archived_at FROM users
+            \\WHERE email = $1 LIMIT 1
+        ;
+
+        pub const FindUserResult = union(enum) {
+            user: models.User,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .user => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn findUser(self: Self, email: []const u8) !FindUserResult {
+            const hook = QueryHook(Hooks).begin("findUser", find_user_sql);
+            const result = self.findUserUnhooked(email);
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(1, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn findUserUnhooked(self: Self, email: []const u8) !FindUserResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.findUserUntimed(conn, email);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .user => |rows| {
+                        rows.deinit();
+                    },
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn findUserUntimed(self: Self, conn: *pg.Conn, email: []const u8) !FindUserResult {
+            const allocator = self.allocator;
+            const result = conn.query(find_user_sql, .{ 
+                email,
+            }) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            const row = (result.next() catch |err| return timeoutError(conn, err)) orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+            const row_name = try allocator.dupe(u8, row.get([]const u8, 1));
+            errdefer allocator.free(row_name);
+            const row_email = try allocator.dupe(u8, row.get([]const u8, 2));
+            errdefer allocator.free(row_email);
+            const row_password = try allocator.dupe(u8, row.get([]const u8, 3));
+            errdefer allocator.free(row_password);
+            const row_role = row.get(models.UserRole, 4);
+            const ip_address_cidr = row.get(?pg.Cidr, 5);
+            const row_ip_address: ?pg.Cidr = blk: {
+                if (ip_address_cidr) |cidr| {
+                    break :blk pg.Cidr{
+                        .address = try allocator.dupe(u8, cidr.address),
+                        .netmask = cidr.netmask,
+                        .family = cidr.family,
+                    };
+                }
+                break :blk null;
+            };
+            errdefer if (row_ip_address) |cidr| {
+                allocator.free(cidr.address);
+            };
+            const salary_numeric = row.get(?pg.Numeric, 6);
+            const row_salary: ?pg.Numeric = blk: {
+                if (salary_numeric) |numeric| {
+                    break :blk pg.Numeric{
+                        .number_of_digits = numeric.number_of_digits,
+                        .weight = numeric.weight,
+                        .sign = numeric.sign,
+                        .scale = numeric.scale,
+                        .digits = try allocator.dupe(u8, numeric.digits),
+                    };
+                }
+                break :blk null;
+            };
+            errdefer if (row_salary) |numeric| {
+                allocator.free(numeric.digits);
+            };
+
+            const maybe_notes = row.get(?[]const u8, 7);
+            const row_notes: ?[]const u8 = blk: {
+                if (maybe_notes) |field| {
+                    break :blk try allocator.dupe(u8, field);
+                }
+                break :blk null;
+            };
+            errdefer if (row_notes) |field| {
+                allocator.free(field);
+            };
+            const row_created_at = row.get(i64, 8);
+            const row_updated_at = row.get(i64, 9);
+            const row_archived_at = row.get(?i64, 10);
+
+            return .{
+                .user = .{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .name = row_name,
+                    .email = row_email,
+                    .password = row_password,
+                    .role = row_role,
+                    .ip_address = row_ip_address,
+                    .salary = row_salary,
+                    .notes = row_notes,
+                    .created_at = row_created_at,
+                    .updated_at = row_updated_at,
+                    .archived_at = row_archived_at,
+                }
+            };
+        }
+
+        const list_user_emails_sql = 
+            \\SELECT id, email FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub const ListUserEmailsRow = struct {
+            id: i32,
+            email: []const u8,
+        };
+
+        pub const ListUserEmailsResult = union(enum) {
+            list_user_emails_row: ListUserEmailsRow,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .list_user_emails_row => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        //  Streams the id and email of every user.
+        pub fn listUserEmails(self: Self, ctx: anytype) !void {
+            const hook = QueryHook(Hooks).begin("listUserEmails", list_user_emails_sql);
+            const result = self.listUserEmailsUnhooked(ctx);
+            if (result) |value| {
+                hook.end(null, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn listUserEmailsUnhooked(self: Self, ctx: anytype) !void {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.listUserEmailsUntimed(conn, ctx);
+            if (result) |value| {
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn listUserEmailsUntimed(self: Self, conn: *pg.Conn, ctx: anytype) !void {
+            _ = self;
+            const result = conn.query(list_user_emails_sql, .{}) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    try ctx.handle(.{ .pgerr = conn._err_data orelse unreachable });
+                    return;
+                }
+                return err;
+            };
+            defer result.deinit();
+            while (result.next() catch |err| return timeoutError(conn, err)) |row| {
+                const row_id = row.get(i32, 0);
+                const row_email = row.get([]const u8, 1);
+                try ctx.handle(.{
+                    .list_user_emails_row = .{
+                        .id = row_id,
+                        .email = row_email,
+                    },
+                });
+            }
+        }
+
+    };
+}
+
+// Routes queries between a primary and a replica pool. Queries returning rows
+// from a SELECT statement run on the replica, all other queries run on the
+// primary. Queries in a transaction always run on the primary.
+pub fn RoutedQuerier(comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+        const Pool = Querier(*pg.Pool, Hooks);
+        const Conn = Querier(*pg.Conn, Hooks);
+
+        primary: Pool,
+        replica: Pool,
+
+        pub fn init(allocator: Allocator, primary: *pg.Pool, replica: *pg.Pool) Self {
+            return .{
+                .primary = Pool.init(allocator, primary),
+                .replica = Pool.init(allocator, replica),
+            };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(*pg.Pool, Hooks) {
+            return QuerierInterface(*pg.Pool, Hooks).init(Self, self);
+        }
+
+        // Begins a transaction on a connection acquired from the primary pool. The
+        // connection is released by commit or rollback.
+        pub fn begin(self: Self) !Transaction {
+            const conn = try self.primary.conn.acquire();
+            errdefer self.primary.conn.release(conn);
+            try conn.begin();
+            return .{
+                .querier = Conn.init(self.primary.allocator, conn),
+                .pool = self.primary.conn,
+            };
+        }
+
+        pub const Transaction = struct {
+            querier: Conn,
+            pool: *pg.Pool,
+
+            pub fn commit(self: Transaction) !void {
+                defer self.pool.release(self.querier.conn);
+                try self.querier.conn.commit();
+            }
+
+            pub fn rollback(self: Transaction) !void {
+                defer self.pool.release(self.querier.conn);
+                try self.querier.conn.rollback();
+            }
+        };
+
+        pub fn archiveUser(self: Self, archive_user_params: Pool.ArchiveUserParams) !Pool.ArchiveUserResult {
+            return self.primary.archiveUser(archive_user_params);
+        }
+
+        pub fn findUser(self: Self, email: []const u8) !Pool.FindUserResult {
+            return self.primary.findUser(email);
+        }
+
+        //  Streams the id and email of every user.
+        pub fn listUserEmails(self: Self, ctx: anytype) !void {
+            return self.replica.listUserEmails(ctx);
+        }
+    };
+}
+
+// The queries of this file, ordered by method name.
+pub const queries = [_]catalog.QueryInfo{
+    .{
+        .name = "archiveUser",
+        .sqlc_name = "ArchiveUser",
+        .file = "annotated.sql",
+        .cmd = ":exec",
+        .sql = ConnQuerier.archive_user_sql,
+        .params = &.{
+            .{ .name = "id", .sql_type = "serial", .nullable = false, .array = false },
+        },
+        .columns = &.{},
+    },
+    .{
+        .name = "findUser",
+        .sqlc_name = "FindUser",
+        .file = "annotated.sql",
+        .cmd = ":one",
+        .sql = ConnQuerier.find_user_sql,
+        .params = &.{
+            .{ .name = "email", .sql_type = "text", .nullable = false, .array = false },
+        },
+        .columns = &.{ "id", "name", "email", "password", "role", "ip_address", "salary", "notes", "created_at", "updated_at", "archived_at" },
+    },
+    .{
+        .name = "listUserEmails",
+        .sqlc_name = "ListUserEmails",
+        .file = "annotated.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.list_user_emails_sql,
+        .params = &.{},
+        .columns = &.{ "id", "email" },
+    },
+};
+
+// Prints the fields of generated structs in format methods.
+const debug_format = struct {
+    fn any(writer: anytype, value: anytype) !void {
+        try writer.print("{any}", .{value});
+    }
+
+    fn string(writer: anytype, value: []const u8) !void {
+        try writer.print("\"{}\"", .{std.zig.fmtEscapes(value)});
+    }
+
+    fn bytes(writer: anytype, value: []const u8) !void {
+        try writer.print("0x{}", .{std.fmt.fmtSliceHexLower(value)});
+    }
+
+    fn uuid(writer: anytype, value: [16]u8) !void {
+        try writer.print("{}-{}-{}-{}-{}", .{
+            std.fmt.fmtSliceHexLower(value[0..4]),
+            std.fmt.fmtSliceHexLower(value[4..6]),
+            std.fmt.fmtSliceHexLower(value[6..8]),
+            std.fmt.fmtSliceHexLower(value[8..10]),
+            std.fmt.fmtSliceHexLower(value[10..16]),
+        });
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC, e.g.
+    // 2024-01-02T03:04:05.000006Z. Timestamps before the epoch are printed as
+    // numbers.
+    fn timestamp(writer: anytype, value: i64) !void {
+        if (value < 0) {
+            return writer.print("{d}", .{value});
+        }
+        try printTimestamp(writer, @intCast(value));
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn @"enum"(writer: anytype, value: anytype) !void {
+        try writer.print(".{s}", .{@tagName(value)});
+    }
+
+    fn cidr(writer: anytype, value: pg.Cidr) !void {
+        if (value.address.len == 4) {
+            try writer.print("{d}.{d}.{d}.{d}", .{ value.address[0], value.address[1], value.address[2], value.address[3] });
+        } else {
+            var i: usize = 0;
+            while (i + 1 < value.address.len) : (i += 2) {
+                if (i > 0) {
+                    try writer.writeByte(':');
+                }
+                try writer.print("{x}", .{@as(u16, value.address[i]) << 8 | value.address[i + 1]});
+            }
+        }
+        try writer.print("/{d}", .{value.netmask});
+    }
+
+    fn numeric(writer: anytype, value: pg.Numeric) !void {
+        try writer.print("{d}", .{value.toFloat()});
+    }
+
+    fn array(writer: anytype, values: anytype, comptime formatValue: anytype) !void {
+        try writer.writeAll("{ ");
+        for (values, 0..) |value, i| {
+            if (i > 0) {
+                try writer.writeAll(", ");
+            }
+            try formatValue(writer, value);
+        }
+        try writer.writeAll(" }");
+    }
+};
+
+// The statement_timeout of a connection before a query with a timeout replaced
+// it. Inside a transaction block the timeout is only set for the transaction,
+// so that a transaction aborted by the query reverts it when rolled back.
+const StatementTimeout = struct {
+    buf: [32]u8 = undefined,
+    len: usize = 0,
+    local: bool = false,
+
+    // Sets the statement_timeout of conn and returns the previous value, in a
+    // single round trip. Outside a transaction block, the statement and
+    // transaction timestamps are the same.
+    fn set(conn: *pg.Conn, timeout: []const u8) !StatementTimeout {
+        const result = try conn.query(
+            \\SELECT current_setting('statement_timeout'),
+            \\  set_config('statement_timeout', $1, statement_timestamp() <> transaction_timestamp()),
+            \\  statement_timestamp() <> transaction_timestamp()
+        , .{timeout});
+        defer result.deinit();
+        const row = (try result.next()) orelse unreachable;
+        const current = row.get([]const u8, 0);
+        var previous = StatementTimeout{ .local = row.get(bool, 2) };
+        if (current.len > previous.buf.len) {
+            return error.InvalidStatementTimeout;
+        }
+        @memcpy(previous.buf[0..current.len], current);
+        previous.len = current.len;
+        return previous;
+    }
+
+    // Restores the statement_timeout of conn saved by set. A transaction
+    // aborted by the query cannot run it, rolling back restores the timeout
+    // instead.
+    fn restore(self: *const StatementTimeout, conn: *pg.Conn) !void {
+        const result = conn.query("SELECT set_config('statement_timeout', $1, $2)", .{ self.buf[0..self.len], self.local }) catch |err| {
+            if (self.local and err == error.PG) {
+                const pge = conn.err orelse return err;
+                // in_failed_sql_transaction
+                if (std.mem.eql(u8, pge.code, "25P02")) {
+                    return;
+                }
+            }
+            return err;
+        };
+        defer result.deinit();
+    }
+};
+
+// Reports whether err was caused by a query exceeding its statement_timeout.
+fn isTimeout(conn: *pg.Conn, err: anyerror) bool {
+    if (err != error.PG) {
+        return false;
+    }
+    const pge = conn.err orelse return false;
+    // query_canceled
+    return std.mem.eql(u8, pge.code, "57014");
+}
+
+// Returns error.Timeout in place of err if it was caused by a query exceeding
+// its statement_timeout.
+fn timeoutError(conn: *pg.Conn, err: anytype) @TypeOf(err) || error{Timeout} {
+    return if (isTimeout(conn, err)) error.Timeout else err;
+}
+
+// Hooks for a Querier that does not observe its queries.
+pub const NoHooks = struct {};
+
+// Calls the optional callbacks declared by Hooks around a query:
+//
+//   pub fn beforeQuery(name: []const u8, sql: []const u8) void
+//   pub fn afterQuery(name: []const u8, duration_ns: u64, rows: ?usize, err: ?anyerror) void
+//
+// The name is the name of the query method. Rows is the number of rows
+// returned, or null for methods passing rows to a context. Callbacks that are
+// not declared are not called, and their overhead compiles away.
+fn QueryHook(comptime Hooks: type) type {
+    return struct {
+        name: []const u8,
+        start: if (@hasDecl(Hooks, "afterQuery")) i128 else void,
+
+        fn begin(name: []const u8, sql: []const u8) @This() {
+            if (@hasDecl(Hooks, "beforeQuery")) {
+                Hooks.beforeQuery(name, sql);
+            }
+            return .{
+                .name = name,
+                .start = if (@hasDecl(Hooks, "afterQuery")) std.time.nanoTimestamp() else {},
+            };
+        }
+
+        fn end(self: @This(), rows: ?usize, err: ?anyerror) void {
+            if (@hasDecl(Hooks, "afterQuery")) {
+                const duration: u64 = @intCast(@max(0, std.time.nanoTimestamp() - self.start));
+                Hooks.afterQuery(self.name, duration, rows, err);
+            }
+        }
+    };
+}
+
+pub const ConnQuerierInterface = QuerierInterface(*pg.Conn, NoHooks);
+pub const PoolQuerierInterface = QuerierInterface(*pg.Pool, NoHooks);
+
+// A type erased interface over the methods of Querier(T). It can be backed by
+// a Querier or any other type declaring the same methods, such as a mock.
+// Methods taking a context are not part of the interface.
+pub fn QuerierInterface(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        ptr: *anyopaque,
+        vtable: *const VTable,
+
+        pub const VTable = struct {
+            archiveUser: *const fn (ptr: *anyopaque, archive_user_params: Querier(T, Hooks).ArchiveUserParams) anyerror!Querier(T, Hooks).ArchiveUserResult,
+            findUser: *const fn (ptr: *anyopaque, email: []const u8) anyerror!Querier(T, Hooks).FindUserResult,
+        };
+
+        // Returns an interface calling the methods of impl.
+        pub fn init(comptime Impl: type, impl: *Impl) Self {
+            return .{ .ptr = impl, .vtable = &VTableFor(Impl).vtable };
+        }
+
+        fn VTableFor(comptime Impl: type) type {
+            return struct {
+                const vtable = VTable{
+                    .archiveUser = struct {
+                        fn call(ptr: *anyopaque, archive_user_params: Querier(T, Hooks).ArchiveUserParams) anyerror!Querier(T, Hooks).ArchiveUserResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.archiveUser(archive_user_params);
+                        }
+                    }.call,
+                    .findUser = struct {
+                        fn call(ptr: *anyopaque, email: []const u8) anyerror!Querier(T, Hooks).FindUserResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.findUser(email);
+                        }
+                    }.call,
+                };
+            };
+        }
+
+        pub fn archiveUser(self: Self, archive_user_params: Querier(T, Hooks).ArchiveUserParams) anyerror!Querier(T, Hooks).ArchiveUserResult {
+            return self.vtable.archiveUser(self.ptr, archive_user_params);
+        }
+
+        pub fn findUser(self: Self, email: []const u8) anyerror!Querier(T, Hooks).FindUserResult {
+            return self.vtable.findUser(self.ptr, email);
+        }
+    };
+}
+
+pub const ConnMockQuerier = MockQuerier(*pg.Conn, NoHooks);
+pub const PoolMockQuerier = MockQuerier(*pg.Pool, NoHooks);
+
+// Records the calls to a single MockQuerier method and the result to return.
+pub fn MockMethod(comptime Args: type, comptime Result: type) type {
+    return struct {
+        // The value returned by the method. Methods returning void succeed
+        // without a result.
+        result: ?Result = null,
+        // An error returned by the method instead of the result.
+        err: ?anyerror = null,
+        // Computes the result of the method from its arguments. Takes
+        // precedence over result and err when set.
+        handler: ?*const fn (args: Args) anyerror!Result = null,
+        // The number of calls expected by MockQuerier.verify, or null to
+        // accept any number of calls.
+        expected_calls: ?usize = null,
+        // The number of times the method was called.
+        calls: usize = 0,
+        // The arguments of the most recent call.
+        last_args: ?Args = null,
+
+        fn call(self: *@This(), args: Args) anyerror!Result {
+            self.calls += 1;
+            self.last_args = args;
+            if (self.handler) |handler| {
+                return handler(args);
+            }
+            if (self.err) |err| {
+                return err;
+            }
+            if (Result == void) {
+                return;
+            }
+            return self.result orelse error.UnexpectedCall;
+        }
+    };
+}
+
+// A mock implementation of the methods of Querier(T) for unit tests. Canned
+// results and expectations are set per method on the expect field, calls
+// without a result set return error.UnexpectedCall unless the method returns
+// void.
+pub fn MockQuerier(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        expect: struct {
+            archiveUser: MockMethod(struct { archive_user_params: Querier(T, Hooks).ArchiveUserParams }, Querier(T, Hooks).ArchiveUserResult) = .{},
+            findUser: MockMethod(struct { email: []const u8 }, Querier(T, Hooks).FindUserResult) = .{},
+        } = .{},
+
+        pub fn interface(self: *Self) QuerierInterface(T, Hooks) {
+            return QuerierInterface(T, Hooks).init(Self, self);
+        }
+
+        // Returns error.UnexpectedCallCount if any method with expected_calls
+        // set was called a different number of times.
+        pub fn verify(self: Self) !void {
+            inline for (std.meta.fields(@TypeOf(self.expect))) |field| {
+                const method = @field(self.expect, field.name);
+                if (method.expected_calls) |expected| {
+                    if (method.calls != expected) {
+                        return error.UnexpectedCallCount;
+                    }
+                }
+            }
+        }
+
+        pub fn archiveUser(self: *Self, archive_user_params: Querier(T, Hooks).ArchiveUserParams) anyerror!Querier(T, Hooks).ArchiveUserResult {
+            return self.expect.archiveUser.call(.{ .archive_user_params = archive_user_params });
+        }
+
+        pub fn findUser(self: *Self, email: []const u8) anyerror!Querier(T, Hooks).FindUserResult {
+            return self.expect.findUser.call(.{ .email = email });
+        }
+    };
+}
@@ -0,0 +1,882 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+const catalog = @import("queries.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn, NoHooks);
+pub const PoolQuerier = Querier(*pg.Pool, NoHooks);
+
+pub fn Querier(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(T, Hooks) {
+            return QuerierInterface(T, Hooks).init(Self, self);
+        }
+        
+        const get_api_key_sql = 
+            \\SELECT id, user_id, secret, fingerprints, created_at, expires_at FROM api_keys
+            \\WHERE id = $1
+        ;
+
+        pub const GetAPIKeyResult = union(enum) {
+            api_key: models.ApiKey,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .api_key => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getAPIKey(self: Self, id: [16]u8) !GetAPIKeyResult {
+            const hook = QueryHook(Hooks).begin("getAPIKey", get_api_key_sql);
+            const result = self.getAPIKeyUnhooked(id);
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(1, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getAPIKeyUnhooked(self: Self, id: [16]u8) !GetAPIKeyResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.getAPIKeyUntimed(conn, id);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .api_key => |rows| {
+                        rows.deinit();
+                    },
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn getAPIKeyUntimed(self: Self, conn: *pg.Conn, id: [16]u8) !GetAPIKeyResult {
+            const allocator = self.allocator;
+            const result = conn.query(get_api_key_sql, .{ 
+                id,
+            }) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            const row = (result.next() catch |err| return timeoutError(conn, err)) orelse return error.NotFound;
+
+            const row_id = try allocator.dupe([16]u8, row.get([16]u8, 0));
+            errdefer allocator.free(row_id);
+            const row_user_id = row.get(i32, 1);
+            const row_secret = try allocator.dupe(u8, row.get([]u8, 2));
+            errdefer allocator.free(row_secret);
+            var row_fingerprints = std.ArrayList([]u8).init(allocator);
+            defer row_fingerprints.deinit();
+            var row_fingerprints_iter = row.get(pg.Iterator([]u8), 3);
+            while (row_fingerprints_iter.next()) |item| {
+                const value = try allocator.dupe(u8, item);
+                errdefer allocator.free(value);
+                try row_fingerprints.append(value);
+            }
+            const row_created_at = row.get(i64, 4);
+            const row_expires_at = row.get(?i64, 5);
+
+            return .{
+                .api_key = .{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .user_id = row_user_id,
+                    .secret = row_secret,
+                    .fingerprints = try row_fingerprints.toOwnedSlice(),
+                    .created_at = row_created_at,
+                    .expires_at = row_expires_at,
+                }
+            };
+        }
+
+        const list_api_key_secrets_sql = 
+            \\SELECT id, secret, expires_at FROM api_keys
+            \\WHERE user_id = $1
+            \\ORDER BY created_at ASC
+        ;
+
+        pub const ListAPIKeySecretsRow = struct {
+            __allocator: Allocator,
+
+            id: [16]u8,
+            secret: []u8,
+            expires_at: ?i64 = null,
+
+            pub fn deinit(self: *const ListAPIKeySecretsRow) void {
+                self.__allocator.free(self.id);
+                self.__allocator.free(self.secret);
+            }
+
+            pub fn jsonStringify(self: @This(), jw: anytype) !void {
+                try jw.beginObject();
+                try jw.objectField("id");
+                try json_format.uuid(jw, self.id);
+                try jw.objectField("secret");
+                try json_format.bytes(jw, self.secret);
+                try jw.objectField("expires_at");
+                if (self.expires_at) |value| {
+                    try json_format.timestamp(jw, value);
+                } else {
+                    try jw.write(null);
+                }
+                try jw.endObject();
+            }
+
+            pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+                _ = fmt;
+                _ = options;
+                try writer.writeAll("ListAPIKeySecretsRow{ .id = ");
+                try debug_format.uuid(writer, self.id);
+                try writer.writeAll(", .secret = ");
+                try debug_format.bytes(writer, self.secret);
+                try writer.writeAll(", .expires_at = ");
+                if (self.expires_at) |value| {
+                    try debug_format.timestamp(writer, value);
+                } else {
+                    try writer.writeAll("null");
+                }
+                try writer.writeAll(" }");
+            }
+
+            // Returns a deep copy of the struct owned by allocator, to be freed with
+            // deinit.
+            pub fn clone(self: @This(), allocator: Allocator) !@This() {
+                return deep.clone(allocator, self);
+            }
+
+            // Reports whether all fields of the structs hold equal values.
+            pub fn eql(self: @This(), other: @This()) bool {
+                return deep.eql(self, other);
+            }
+
+            // Hashes the values of all fields, consistent with eql.
+            pub fn hash(self: @This()) u64 {
+                var hasher = std.hash.Wyhash.init(0);
+                deep.hash(&hasher, self);
+                return hasher.final();
+            }
+        };
+
+        pub const ListAPIKeySecretsResult = union(enum) {
+            list_api_key_secrets_row_list: []ListAPIKeySecretsRow,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .list_api_key_secrets_row_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn listAPIKeySecrets(self: Self, user_id: i32) !ListAPIKeySecretsResult {
+            const hook = QueryHook(Hooks).begin("listAPIKeySecrets", list_api_key_secrets_sql);
+            const result = self.listAPIKeySecretsUnhooked(user_id);
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(value.list_api_key_secrets_row_list.len, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn listAPIKeySecretsUnhooked(self: Self, user_id: i32) !ListAPIKeySecretsResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.listAPIKeySecretsUntimed(conn, user_id);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .list_api_key_secrets_row_list => |rows| {
+                        self.freeListAPIKeySecrets(rows);
+                    },
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn listAPIKeySecretsUntimed(self: Self, conn: *pg.Conn, user_id: i32) !ListAPIKeySecretsResult {
+            const allocator = self.allocator;
+            const result = conn.query(list_api_key_secrets_sql, .{ 
+                user_id,
+            }) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList(ListAPIKeySecretsRow).init(allocator);
+            defer out.deinit();
+            while (result.next() catch |err| return timeoutError(conn, err)) |row| {
+                const row_id = try allocator.dupe([16]u8, row.get([16]u8, 0));
+                errdefer allocator.free(row_id);
+                const row_secret = try allocator.dupe(u8, row.get([]u8, 1));
+                errdefer allocator.free(row_secret);
+                const row_expires_at = row.get(?i64, 2);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .secret = row_secret,
+                    .expires_at = row_expires_at,
+                });
+            }
+
+            return .{
+                .list_api_key_secrets_row_list = try out.toOwnedSlice(),
+            };
+        }
+
+        // Frees the rows returned by listAPIKeySecrets along with the slice
+        // holding them.
+        pub fn freeListAPIKeySecrets(self: Self, rows: []const ListAPIKeySecretsRow) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
+
+// Routes queries between a primary and a replica pool. Queries returning rows
+// from a SELECT statement run on the replica, all other queries run on the
+// primary. Queries in a transaction always run on the primary.
+pub fn RoutedQuerier(comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+        const Pool = Querier(*pg.Pool, Hooks);
+        const Conn = Querier(*pg.Conn, Hooks);
+
+        primary: Pool,
+        replica: Pool,
+
+        pub fn init(allocator: Allocator, primary: *pg.Pool, replica: *pg.Pool) Self {
+            return .{
+                .primary = Pool.init(allocator, primary),
+                .replica = Pool.init(allocator, replica),
+            };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(*pg.Pool, Hooks) {
+            return QuerierInterface(*pg.Pool, Hooks).init(Self, self);
+        }
+
+        // Begins a transaction on a connection acquired from the primary pool. The
+        // connection is released by commit or rollback.
+        pub fn begin(self: Self) !Transaction {
+            const conn = try self.primary.conn.acquire();
+            errdefer self.primary.conn.release(conn);
+            try conn.begin();
+            return .{
+                .querier = Conn.init(self.primary.allocator, conn),
+                .pool = self.primary.conn,
+            };
+        }
+
+        pub const Transaction = struct {
+            querier: Conn,
+            pool: *pg.Pool,
+
+            pub fn commit(self: Transaction) !void {
+                defer self.pool.release(self.querier.conn);
+                try self.querier.conn.commit();
+            }
+
+            pub fn rollback(self: Transaction) !void {
+                defer self.pool.release(self.querier.conn);
+                try self.querier.conn.rollback();
+            }
+        };
+
+        pub fn getAPIKey(self: Self, id: [16]u8) !Pool.GetAPIKeyResult {
+            return self.replica.getAPIKey(id);
+        }
+
+        pub fn listAPIKeySecrets(self: Self, user_id: i32) !Pool.ListAPIKeySecretsResult {
+            return self.replica.listAPIKeySecrets(user_id);
+        }
+
+        pub fn freeListAPIKeySecrets(self: Self, rows: []const Pool.ListAPIKeySecretsRow) void {
+            self.primary.freeListAPIKeySecrets(rows);
+        }
+    };
+}
+
+// The queries of this file, ordered by method name.
+pub const queries = [_]catalog.QueryInfo{
+    .{
+        .name = "getAPIKey",
+        .sqlc_name = "GetAPIKey",
+        .file = "api_keys.sql",
+        .cmd = ":one",
+        .sql = ConnQuerier.get_api_key_sql,
+        .params = &.{
+            .{ .name = "id", .sql_type = "uuid", .nullable = false, .array = false },
+        },
+        .columns = &.{ "id", "user_id", "secret", "fingerprints", "created_at", "expires_at" },
+    },
+    .{
+        .name = "listAPIKeySecrets",
+        .sqlc_name = "ListAPIKeySecrets",
+        .file = "api_keys.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.list_api_key_secrets_sql,
+        .params = &.{
+            .{ .name = "user_id", .sql_type = "int4", .nullable = false, .array = false },
+        },
+        .columns = &.{ "id", "secret", "expires_at" },
+    },
+};
+
+// Writes values that std.json cannot write as is in generated jsonStringify
+// methods.
+const json_format = struct {
+    // Writes binary data as a base64 encoded string.
+    fn bytes(jw: anytype, value: []const u8) !void {
+        try jw.beginWriteRaw();
+        try jw.stream.writeByte('"');
+        try std.base64.standard.Encoder.encodeWriter(jw.stream, value);
+        try jw.stream.writeByte('"');
+        jw.endWriteRaw();
+    }
+
+    // Writes a UUID in its canonical form, e.g.
+    // "123e4567-e89b-12d3-a456-426614174000".
+    fn uuid(jw: anytype, value: [16]u8) !void {
+        const hex = "0123456789abcdef";
+        var buf: [36]u8 = undefined;
+        var i: usize = 0;
+        for (value, 0..) |byte, idx| {
+            if (idx == 4 or idx == 6 or idx == 8 or idx == 10) {
+                buf[i] = '-';
+                i += 1;
+            }
+            buf[i] = hex[byte >> 4];
+            buf[i + 1] = hex[byte & 0x0f];
+            i += 2;
+        }
+        try jw.write(buf[0..]);
+    }
+
+    // Writes a timestamp in microseconds since the Unix epoch as an RFC 3339
+    // string in UTC, e.g. "2024-01-02T03:04:05.000006Z". Timestamps before the
+    // epoch are written as numbers.
+    fn timestamp(jw: anytype, value: i64) !void {
+        if (value < 0) {
+            return jw.write(value);
+        }
+        try jw.beginWriteRaw();
+        try jw.stream.writeByte('"');
+        try printTimestamp(jw.stream, @intCast(value));
+        try jw.stream.writeByte('"');
+        jw.endWriteRaw();
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn array(jw: anytype, values: anytype, comptime writeValue: anytype) !void {
+        try jw.beginArray();
+        for (values) |value| {
+            try writeValue(jw, value);
+        }
+        try jw.endArray();
+    }
+};
+
+// Walks the fields of generated structs for the clone, eql and hash methods.
+// Slices are followed and the internal allocator field is skipped.
+const deep = struct {
+    fn clone(allocator: Allocator, value: anytype) !@TypeOf(value) {
+        const T = @TypeOf(value);
+        switch (@typeInfo(T)) {
+            .optional => return if (value) |v| try clone(allocator, v) else null,
+            .pointer => |ptr| {
+                switch (@typeInfo(ptr.child)) {
+                    .optional, .pointer, .@"struct" => {},
+                    else => return allocator.dupe(ptr.child, value),
+                }
+                const out = try allocator.alloc(ptr.child, value.len);
+                errdefer allocator.free(out);
+                var cloned: usize = 0;
+                errdefer for (out[0..cloned]) |item| free(allocator, item);
+                for (value) |item| {
+                    out[cloned] = try clone(allocator, item);
+                    cloned += 1;
+                }
+                return out;
+            },
+            .@"struct" => |info| {
+                var out = value;
+                var cloned: usize = 0;
+                errdefer inline for (info.fields, 0..) |field, i| {
+                    if (i < cloned and !comptime isAllocator(field.name)) {
+                        free(allocator, @field(out, field.name));
+                    }
+                }
+                inline for (info.fields) |field| {
+                    if (comptime isAllocator(field.name)) {
+                        out.__allocator = allocator;
+                    } else {
+                        @field(out, field.name) = try clone(allocator, @field(value, field.name));
+                    }
+                    cloned += 1;
+                }
+                return out;
+            },
+            else => return value,
+        }
+    }
+
+    fn free(allocator: Allocator, value: anytype) void {
+        switch (@typeInfo(@TypeOf(value))) {
+            .optional => if (value) |v| free(allocator, v),
+            .pointer => {
+                for (value) |item| free(allocator, item);
+                allocator.free(value);
+            },
+            .@"struct" => |info| inline for (info.fields) |field| {
+                if (!comptime isAllocator(field.name)) {
+                    free(allocator, @field(value, field.name));
+                }
+            },
+            else => {},
+        }
+    }
+
+    fn eql(a: anytype, b: @TypeOf(a)) bool {
+        switch (@typeInfo(@TypeOf(a))) {
+            .optional => {
+                const x = a orelse return b == null;
+                const y = b orelse return false;
+                return eql(x, y);
+            },
+            .pointer => {
+                if (a.len != b.len) {
+                    return false;
+                }
+                for (a, b) |x, y| {
+                    if (!eql(x, y)) {
+                        return false;
+                    }
+                }
+                return true;
+            },
+            .array => return std.mem.eql(@typeInfo(@TypeOf(a)).array.child, &a, &b),
+            .@"struct" => |info| {
+                inline for (info.fields) |field| {
+                    if (!comptime isAllocator(field.name) and !eql(@field(a, field.name), @field(b, field.name))) {
+                        return false;
+                    }
+                }
+                return true;
+            },
+            else => return a == b,
+        }
+    }
+
+    fn hash(hasher: *std.hash.Wyhash, value: anytype) void {
+        switch (@typeInfo(@TypeOf(value))) {
+            .optional => {
+                hasher.update(&[_]u8{@intFromBool(value != null)});
+                if (value) |v| hash(hasher, v);
+            },
+            .pointer => {
+                hasher.update(std.mem.asBytes(&value.len));
+                for (value) |item| hash(hasher, item);
+            },
+            .@"struct" => |info| inline for (info.fields) |field| {
+                if (!comptime isAllocator(field.name)) {
+                    hash(hasher, @field(value, field.name));
+                }
+            },
+            else => hasher.update(std.mem.asBytes(&value)),
+        }
+    }
+
+    fn isAllocator(comptime name: []const u8) bool {
+        return std.mem.eql(u8, name, "__allocator");
+    }
+};
+
+// Prints the fields of generated structs in format methods.
+const debug_format = struct {
+    fn any(writer: anytype, value: anytype) !void {
+        try writer.print("{any}", .{value});
+    }
+
+    fn string(writer: anytype, value: []const u8) !void {
+        try writer.print("\"{}\"", .{std.zig.fmtEscapes(value)});
+    }
+
+    fn bytes(writer: anytype, value: []const u8) !void {
+        try writer.print("0x{}", .{std.fmt.fmtSliceHexLower(value)});
+    }
+
+    fn uuid(writer: anytype, value: [16]u8) !void {
+        try writer.print("{}-{}-{}-{}-{}", .{
+            std.fmt.fmtSliceHexLower(value[0..4]),
+            std.fmt.fmtSliceHexLower(value[4..6]),
+            std.fmt.fmtSliceHexLower(value[6..8]),
+            std.fmt.fmtSliceHexLower(value[8..10]),
+            std.fmt.fmtSliceHexLower(value[10..16]),
+        });
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC, e.g.
+    // 2024-01-02T03:04:05.000006Z. Timestamps before the epoch are printed as
+    // numbers.
+    fn timestamp(writer: anytype, value: i64) !void {
+        if (value < 0) {
+            return writer.print("{d}", .{value});
+        }
+        try printTimestamp(writer, @intCast(value));
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn @"enum"(writer: anytype, value: anytype) !void {
+        try writer.print(".{s}", .{@tagName(value)});
+    }
+
+    fn cidr(writer: anytype, value: pg.Cidr) !void {
+        if (value.address.len == 4) {
+            try writer.print("{d}.{d}.{d}.{d}", .{ value.address[0], value.address[1], value.address[2], value.address[3] });
+        } else {
+            var i: usize = 0;
+            while (i + 1 < value.address.len) : (i += 2) {
+                if (i > 0) {
+                    try writer.writeByte(':');
+                }
+                try writer.print("{x}", .{@as(u16, value.address[i]) << 8 | value.address[i + 1]});
+            }
+        }
+        try writer.print("/{d}", .{value.netmask});
+    }
+
+    fn numeric(writer: anytype, value: pg.Numeric) !void {
+        try writer.print("{d}", .{value.toFloat()});
+    }
+
+    fn array(writer: anytype, values: anytype, comptime formatValue: anytype) !void {
+        try writer.writeAll("{ ");
+        for (values, 0..) |value, i| {
+            if (i > 0) {
+                try writer.writeAll(", ");
+            }
+            try formatValue(writer, value);
+        }
+        try writer.writeAll(" }");
+    }
+};
+
+// The statement_timeout of a connection before a query with a timeout replaced
+// it. Inside a transaction block the timeout is only set for the transaction,
+// so that a transaction aborted by the query reverts it when rolled back.
+const StatementTimeout = struct {
+    buf: [32]u8 = undefined,
+    len: usize = 0,
+    local: bool = false,
+
+    // Sets the statement_timeout of conn and returns the previous value, in a
+    // single round trip. Outside a transaction block, the statement and
+    // transaction timestamps are the same.
+    fn set(conn: *pg.Conn, timeout: []const u8) !StatementTimeout {
+        const result = try conn.query(
+            \\SELECT current_setting('statement_timeout'),
+            \\  set_config('statement_timeout', $1, statement_timestamp() <> transaction_timestamp()),
+            \\  statement_timestamp() <> transaction_timestamp()
+        , .{timeout});
+        defer result.deinit();
+        const row = (try result.next()) orelse unreachable;
+        const current = row.get([]const u8, 0);
+        var previous = StatementTimeout{ .local = row.get(bool, 2) };
+        if (current.len > previous.buf.len) {
+            return error.InvalidStatementTimeout;
+        }
+        @memcpy(previous.buf[0..current.len], current);
+        previous.len = current.len;
+        return previous;
+    }
+
+    // Restores the statement_timeout of conn saved by set. A transaction
+    // aborted by the query cannot run it, rolling back restores the timeout
+    // instead.
+    fn restore(self: *const StatementTimeout, conn: *pg.Conn) !void {
+        const result = conn.query("SELECT set_config('statement_timeout', $1, $2)", .{ self.buf[0..self.len], self.local }) catch |err| {
+            if (self.local and err == error.PG) {
+                const pge = conn.err orelse return err;
+                // in_failed_sql_transaction
+                if (std.mem.eql(u8, pge.code, "25P02")) {
+                    return;
+                }
+            }
+            return err;
+        };
+        defer result.deinit();
+    }
+};
+
+// Reports whether err was caused by a query exceeding its statement_timeout.
+fn isTimeout(conn: *pg.Conn, err: anyerror) bool {
+    if (err != error.PG) {
+        return false;
+    }
+    const pge = conn.err orelse return false;
+    // query_canceled
+    return std.mem.eql(u8, pge.code, "57014");
+}
+
+// Returns error.Timeout in place of err if it was caused by a query exceeding
+// its statement_timeout.
+fn timeoutError(conn: *pg.Conn, err: anytype) @TypeOf(err) || error{Timeout} {
+    return if (isTimeout(conn, err)) error.Timeout else err;
+}
+
+// Hooks for a Querier that does not observe its queries.
+pub const NoHooks = struct {};
+
+// Calls the optional callbacks declared by Hooks around a query:
+//
+//   pub fn beforeQuery(name: []const u8, sql: []const u8) void
+//   pub fn afterQuery(name: []const u8, duration_ns: u64, rows: ?usize, err: ?anyerror) void
+//
+// The name is the name of the query method. Rows is the number of rows
+// returned, or null for methods passing rows to a context. Callbacks that are
+// not declared are not called, and their overhead compiles away.
+fn QueryHook(comptime Hooks: type) type {
+    return struct {
+        name: []const u8,
+        start: if (@hasDecl(Hooks, "afterQuery")) i128 else void,
+
+        fn begin(name: []const u8, sql: []const u8) @This() {
+            if (@hasDecl(Hooks, "beforeQuery")) {
+                Hooks.beforeQuery(name, sql);
+            }
+            return .{
+                .name = name,
+                .start = if (@hasDecl(Hooks, "afterQuery")) std.time.nanoTimestamp() else {},
+            };
+        }
+
+        fn end(self: @This(), rows: ?usize, err: ?anyerror) void {
+            if (@hasDecl(Hooks, "afterQuery")) {
+                const duration: u64 = @intCast(@max(0, std.time.nanoTimestamp() - self.start));
+                Hooks.afterQuery(self.name, duration, rows, err);
+            }
+        }
+    };
+}
+
+pub const ConnQuerierInterface = QuerierInterface(*pg.Conn, NoHooks);
+pub const PoolQuerierInterface = QuerierInterface(*pg.Pool, NoHooks);
+
+// A type erased interface over the methods of Querier(T). It can be backed by
+// a Querier or any other type declaring the same methods, such as a mock.
+// Methods taking a context are not part of the interface.
+pub fn QuerierInterface(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        ptr: *anyopaque,
+        vtable: *const VTable,
+
+        pub const VTable = struct {
+            getAPIKey: *const fn (ptr: *anyopaque, id: [16]u8) anyerror!Querier(T, Hooks).GetAPIKeyResult,
+            listAPIKeySecrets: *const fn (ptr: *anyopaque, user_id: i32) anyerror!Querier(T, Hooks).ListAPIKeySecretsResult,
+        };
+
+        // Returns an interface calling the methods of impl.
+        pub fn init(comptime Impl: type, impl: *Impl) Self {
+            return .{ .ptr = impl, .vtable = &VTableFor(Impl).vtable };
+        }
+
+        fn VTableFor(comptime Impl: type) type {
+            return struct {
+                const vtable = VTable{
+                    .getAPIKey = struct {
+                        fn call(ptr: *anyopaque, id: [16]u8) anyerror!Querier(T, Hooks).GetAPIKeyResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getAPIKey(id);
+                        }
+                    }.call,
+                    .listAPIKeySecrets = struct {
+                        fn call(ptr: *anyopaque, user_id: i32) anyerror!Querier(T, Hooks).ListAPIKeySecretsResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.listAPIKeySecrets(user_id);
+                        }
+                    }.call,
+                };
+            };
+        }
+
+        pub fn getAPIKey(self: Self, id: [16]u8) anyerror!Querier(T, Hooks).GetAPIKeyResult {
+            return self.vtable.getAPIKey(self.ptr, id);
+        }
+
+        pub fn listAPIKeySecrets(self: Self, user_id: i32) anyerror!Querier(T, Hooks).ListAPIKeySecretsResult {
+            return self.vtable.listAPIKeySecrets(self.ptr, user_id);
+        }
+    };
+}
+
+pub const ConnMockQuerier = MockQuerier(*pg.Conn, NoHooks);
+pub const PoolMockQuerier = MockQuerier(*pg.Pool, NoHooks);
+
+// Records the calls to a single MockQuerier method and the result to return.
+pub fn MockMethod(comptime Args: type, comptime Result: type) type {
+    return struct {
+        // The value returned by the method. Methods returning void succeed
+        // without a result.
+        result: ?Result = null,
+        // An error returned by the method instead of the result.
+        err: ?anyerror = null,
+        // Computes the result of the method from its arguments. Takes
+        // precedence over result and err when set.
+        handler: ?*const fn (args: Args) anyerror!Result = null,
+        // The number of calls expected by MockQuerier.verify, or null to
+        // accept any number of calls.
+        expected_calls: ?usize = null,
+        // The number of times the method was called.
+        calls: usize = 0,
+        // The arguments of the most recent call.
+        last_args: ?Args = null,
+
+        fn call(self: *@This(), args: Args) anyerror!Result {
+            self.calls += 1;
+            self.last_args = args;
+            if (self.handler) |handler| {
+                return handler(args);
+            }
+            if (self.err) |err| {
+                return err;
+            }
+            if (Result == void) {
+                return;
+            }
+            return self.result orelse error.UnexpectedCall;
+        }
+    };
+}
+
+// A mock implementation of the methods of Querier(T) for unit tests. Canned
+// results and expectations are set per method on the expect field, calls
+// without a result set return error.UnexpectedCall unless the method returns
+// void.
+pub fn MockQuerier(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        expect: struct {
+            getAPIKey: MockMethod(struct { id: [16]u8 }, Querier(T, Hooks).GetAPIKeyResult) = .{},
+            listAPIKeySecrets: MockMethod(struct { user_id: i32 }, Querier(T, Hooks).ListAPIKeySecretsResult) = .{},
+        } = .{},
+
+        pub fn interface(self: *Self) QuerierInterface(T, Hooks) {
+            return QuerierInterface(T, Hooks).init(Self, self);
+        }
+
+        // Returns error.UnexpectedCallCount if any method with expected_calls
+        // set was called a different number of times.
+        pub fn verify(self: Self) !void {
+            inline for (std.meta.fields(@TypeOf(self.expect))) |field| {
+                const method = @field(self.expect, field.name);
+                if (method.expected_calls) |expected| {
+                    if (method.calls != expected) {
+                        return error.UnexpectedCallCount;
+                    }
+                }
+            }
+        }
+
+        pub fn getAPIKey(self: *Self, id: [16]u8) anyerror!Querier(T, Hooks).GetAPIKeyResult {
+            return self.expect.getAPIKey.call(.{ .id = id });
+        }
+
+        pub fn listAPIKeySecrets(self: *Self, user_id: i32) anyerror!Querier(T, Hooks).ListAPIKeySecretsResult {
+            return self.expect.listAPIKeySecrets.call(.{ .user_id = user_id });
+        }
+    };
+}
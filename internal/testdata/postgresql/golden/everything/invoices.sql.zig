@@ -0,0 +1,753 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+const catalog = @import("queries.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn, NoHooks);
+pub const PoolQuerier = Querier(*pg.Pool, NoHooks);
+
+pub fn Querier(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(T, Hooks) {
+            return QuerierInterface(T, Hooks).init(Self, self);
+        }
+        
+        const create_invoice_sql = 
+            \\INSERT INTO billing.invoices (user_id, status, amount, memo) VALUES ($1, $2, $3, $4)
+        ;
+
+        pub const CreateInvoiceParams = struct {
+            user_id: i32,
+            status: models.billing.InvoiceStatus,
+            amount: f64,
+            memo: ?[]const u8 = null,
+
+            pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+                _ = fmt;
+                _ = options;
+                try writer.writeAll("CreateInvoiceParams{ .user_id = ");
+                try debug_format.any(writer, self.user_id);
+                try writer.writeAll(", .status = ");
+                try debug_format.@"enum"(writer, self.status);
+                try writer.writeAll(", .amount = ");
+                try debug_format.any(writer, self.amount);
+                try writer.writeAll(", .memo = ");
+                if (self.memo) |value| {
+                    try debug_format.string(writer, value);
+                } else {
+                    try writer.writeAll("null");
+                }
+                try writer.writeAll(" }");
+            }
+        };
+
+        pub const CreateInvoiceResult = union(enum) {
+            ok: void,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .ok => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn createInvoice(self: Self, create_invoice_params: CreateInvoiceParams) !CreateInvoiceResult {
+            const hook = QueryHook(Hooks).begin("createInvoice", create_invoice_sql);
+            const result = self.createInvoiceUnhooked(create_invoice_params);
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(0, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn createInvoiceUnhooked(self: Self, create_invoice_params: CreateInvoiceParams) !CreateInvoiceResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.createInvoiceUntimed(conn, create_invoice_params);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .ok => {},
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn createInvoiceUntimed(self: Self, conn: *pg.Conn, create_invoice_params: CreateInvoiceParams) !CreateInvoiceResult {
+            const allocator = self.allocator;
+            _ = conn.exec(create_invoice_sql, .{ 
+                create_invoice_params.user_id,
+                create_invoice_params.status,
+                create_invoice_params.amount,
+                create_invoice_params.memo,
+            }) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            return .{ .ok = undefined };
+        }
+
+        const get_invoice_status_sql = 
+            \\SELECT status FROM billing.invoices
+            \\WHERE id = $1
+        ;
+
+        pub const GetInvoiceStatusResult = union(enum) {
+            status: models.billing.InvoiceStatus,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .status => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getInvoiceStatus(self: Self, id: i32) !GetInvoiceStatusResult {
+            const hook = QueryHook(Hooks).begin("getInvoiceStatus", get_invoice_status_sql);
+            const result = self.getInvoiceStatusUnhooked(id);
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(1, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getInvoiceStatusUnhooked(self: Self, id: i32) !GetInvoiceStatusResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.getInvoiceStatusUntimed(conn, id);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .status => {},
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn getInvoiceStatusUntimed(self: Self, conn: *pg.Conn, id: i32) !GetInvoiceStatusResult {
+            const allocator = self.allocator;
+            const result = conn.query(get_invoice_status_sql, .{ 
+                id,
+            }) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            const row = (result.next() catch |err| return timeoutError(conn, err)) orelse return error.NotFound;
+
+            const row_status = row.get(models.billing.InvoiceStatus, 0);
+
+            return .{ .status = row_status};
+        }
+
+        const get_invoices_sql = 
+            \\SELECT id, user_id, status, amount, memo FROM billing.invoices
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetInvoicesResult = union(enum) {
+            invoice_list: []models.billing.Invoice,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .invoice_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getInvoices(self: Self) !GetInvoicesResult {
+            const hook = QueryHook(Hooks).begin("getInvoices", get_invoices_sql);
+            const result = self.getInvoicesUnhooked();
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(value.invoice_list.len, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getInvoicesUnhooked(self: Self) !GetInvoicesResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.getInvoicesUntimed(conn);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .invoice_list => |rows| {
+                        self.freeGetInvoices(rows);
+                    },
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn getInvoicesUntimed(self: Self, conn: *pg.Conn) !GetInvoicesResult {
+            const allocator = self.allocator;
+            const result = conn.query(get_invoices_sql, .{}) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList(models.billing.Invoice).init(allocator);
+            defer out.deinit();
+            while (result.next() catch |err| return timeoutError(conn, err)) |row| {
+                const row_id = row.get(i32, 0);
+                const row_user_id = row.get(i32, 1);
+                const row_status = row.get(models.billing.InvoiceStatus, 2);
+                const amount_numeric = row.get(pg.Numeric, 3);const row_amount = pg.Numeric{
+                    .number_of_digits = amount_numeric.number_of_digits,
+                    .weight = amount_numeric.weight,
+                    .sign = amount_numeric.sign,
+                    .scale = amount_numeric.scale,
+                    .digits = try allocator.dupe(u8, amount_numeric.digits),
+                };
+                errdefer allocator.free(row_amount.digits);
+
+                const maybe_memo = row.get(?[]const u8, 4);
+                const row_memo: ?[]const u8 = blk: {
+                    if (maybe_memo) |field| {
+                        break :blk try allocator.dupe(u8, field);
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_memo) |field| {
+                    allocator.free(field);
+                };
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .user_id = row_user_id,
+                    .status = row_status,
+                    .amount = row_amount,
+                    .memo = row_memo,
+                });
+            }
+
+            return .{
+                .invoice_list = try out.toOwnedSlice(),
+            };
+        }
+
+        // Frees the rows returned by getInvoices along with the slice
+        // holding them.
+        pub fn freeGetInvoices(self: Self, rows: []const models.billing.Invoice) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
+
+// Routes queries between a primary and a replica pool. Queries returning rows
+// from a SELECT statement run on the replica, all other queries run on the
+// primary. Queries in a transaction always run on the primary.
+pub fn RoutedQuerier(comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+        const Pool = Querier(*pg.Pool, Hooks);
+        const Conn = Querier(*pg.Conn, Hooks);
+
+        primary: Pool,
+        replica: Pool,
+
+        pub fn init(allocator: Allocator, primary: *pg.Pool, replica: *pg.Pool) Self {
+            return .{
+                .primary = Pool.init(allocator, primary),
+                .replica = Pool.init(allocator, replica),
+            };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(*pg.Pool, Hooks) {
+            return QuerierInterface(*pg.Pool, Hooks).init(Self, self);
+        }
+
+        // Begins a transaction on a connection acquired from the primary pool. The
+        // connection is released by commit or rollback.
+        pub fn begin(self: Self) !Transaction {
+            const conn = try self.primary.conn.acquire();
+            errdefer self.primary.conn.release(conn);
+            try conn.begin();
+            return .{
+                .querier = Conn.init(self.primary.allocator, conn),
+                .pool = self.primary.conn,
+            };
+        }
+
+        pub const Transaction = struct {
+            querier: Conn,
+            pool: *pg.Pool,
+
+            pub fn commit(self: Transaction) !void {
+                defer self.pool.release(self.querier.conn);
+                try self.querier.conn.commit();
+            }
+
+            pub fn rollback(self: Transaction) !void {
+                defer self.pool.release(self.querier.conn);
+                try self.querier.conn.rollback();
+            }
+        };
+
+        pub fn createInvoice(self: Self, create_invoice_params: Pool.CreateInvoiceParams) !Pool.CreateInvoiceResult {
+            return self.primary.createInvoice(create_invoice_params);
+        }
+
+        pub fn getInvoiceStatus(self: Self, id: i32) !Pool.GetInvoiceStatusResult {
+            return self.replica.getInvoiceStatus(id);
+        }
+
+        pub fn getInvoices(self: Self) !Pool.GetInvoicesResult {
+            return self.replica.getInvoices();
+        }
+
+        pub fn freeGetInvoices(self: Self, rows: []const models.billing.Invoice) void {
+            self.primary.freeGetInvoices(rows);
+        }
+    };
+}
+
+// The queries of this file, ordered by method name.
+pub const queries = [_]catalog.QueryInfo{
+    .{
+        .name = "createInvoice",
+        .sqlc_name = "CreateInvoice",
+        .file = "invoices.sql",
+        .cmd = ":exec",
+        .sql = ConnQuerier.create_invoice_sql,
+        .params = &.{
+            .{ .name = "user_id", .sql_type = "int4", .nullable = false, .array = false },
+            .{ .name = "status", .sql_type = "billing.invoice_status", .nullable = false, .array = false },
+            .{ .name = "amount", .sql_type = "numeric", .nullable = false, .array = false },
+            .{ .name = "memo", .sql_type = "text", .nullable = true, .array = false },
+        },
+        .columns = &.{},
+    },
+    .{
+        .name = "getInvoiceStatus",
+        .sqlc_name = "GetInvoiceStatus",
+        .file = "invoices.sql",
+        .cmd = ":one",
+        .sql = ConnQuerier.get_invoice_status_sql,
+        .params = &.{
+            .{ .name = "id", .sql_type = "serial", .nullable = false, .array = false },
+        },
+        .columns = &.{ "status" },
+    },
+    .{
+        .name = "getInvoices",
+        .sqlc_name = "GetInvoices",
+        .file = "invoices.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_invoices_sql,
+        .params = &.{},
+        .columns = &.{ "id", "user_id", "status", "amount", "memo" },
+    },
+};
+
+// Prints the fields of generated structs in format methods.
+const debug_format = struct {
+    fn any(writer: anytype, value: anytype) !void {
+        try writer.print("{any}", .{value});
+    }
+
+    fn string(writer: anytype, value: []const u8) !void {
+        try writer.print("\"{}\"", .{std.zig.fmtEscapes(value)});
+    }
+
+    fn bytes(writer: anytype, value: []const u8) !void {
+        try writer.print("0x{}", .{std.fmt.fmtSliceHexLower(value)});
+    }
+
+    fn uuid(writer: anytype, value: [16]u8) !void {
+        try writer.print("{}-{}-{}-{}-{}", .{
+            std.fmt.fmtSliceHexLower(value[0..4]),
+            std.fmt.fmtSliceHexLower(value[4..6]),
+            std.fmt.fmtSliceHexLower(value[6..8]),
+            std.fmt.fmtSliceHexLower(value[8..10]),
+            std.fmt.fmtSliceHexLower(value[10..16]),
+        });
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC, e.g.
+    // 2024-01-02T03:04:05.000006Z. Timestamps before the epoch are printed as
+    // numbers.
+    fn timestamp(writer: anytype, value: i64) !void {
+        if (value < 0) {
+            return writer.print("{d}", .{value});
+        }
+        try printTimestamp(writer, @intCast(value));
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn @"enum"(writer: anytype, value: anytype) !void {
+        try writer.print(".{s}", .{@tagName(value)});
+    }
+
+    fn cidr(writer: anytype, value: pg.Cidr) !void {
+        if (value.address.len == 4) {
+            try writer.print("{d}.{d}.{d}.{d}", .{ value.address[0], value.address[1], value.address[2], value.address[3] });
+        } else {
+            var i: usize = 0;
+            while (i + 1 < value.address.len) : (i += 2) {
+                if (i > 0) {
+                    try writer.writeByte(':');
+                }
+                try writer.print("{x}", .{@as(u16, value.address[i]) << 8 | value.address[i + 1]});
+            }
+        }
+        try writer.print("/{d}", .{value.netmask});
+    }
+
+    fn numeric(writer: anytype, value: pg.Numeric) !void {
+        try writer.print("{d}", .{value.toFloat()});
+    }
+
+    fn array(writer: anytype, values: anytype, comptime formatValue: anytype) !void {
+        try writer.writeAll("{ ");
+        for (values, 0..) |value, i| {
+            if (i > 0) {
+                try writer.writeAll(", ");
+            }
+            try formatValue(writer, value);
+        }
+        try writer.writeAll(" }");
+    }
+};
+
+// The statement_timeout of a connection before a query with a timeout replaced
+// it. Inside a transaction block the timeout is only set for the transaction,
+// so that a transaction aborted by the query reverts it when rolled back.
+const StatementTimeout = struct {
+    buf: [32]u8 = undefined,
+    len: usize = 0,
+    local: bool = false,
+
+    // Sets the statement_timeout of conn and returns the previous value, in a
+    // single round trip. Outside a transaction block, the statement and
+    // transaction timestamps are the same.
+    fn set(conn: *pg.Conn, timeout: []const u8) !StatementTimeout {
+        const result = try conn.query(
+            \\SELECT current_setting('statement_timeout'),
+            \\  set_config('statement_timeout', $1, statement_timestamp() <> transaction_timestamp()),
+            \\  statement_timestamp() <> transaction_timestamp()
+        , .{timeout});
+        defer result.deinit();
+        const row = (try result.next()) orelse unreachable;
+        const current = row.get([]const u8, 0);
+        var previous = StatementTimeout{ .local = row.get(bool, 2) };
+        if (current.len > previous.buf.len) {
+            return error.InvalidStatementTimeout;
+        }
+        @memcpy(previous.buf[0..current.len], current);
+        previous.len = current.len;
+        return previous;
+    }
+
+    // Restores the statement_timeout of conn saved by set. A transaction
+    // aborted by the query cannot run it, rolling back restores the timeout
+    // instead.
+    fn restore(self: *const StatementTimeout, conn: *pg.Conn) !void {
+        const result = conn.query("SELECT set_config('statement_timeout', $1, $2)", .{ self.buf[0..self.len], self.local }) catch |err| {
+            if (self.local and err == error.PG) {
+                const pge = conn.err orelse return err;
+                // in_failed_sql_transaction
+                if (std.mem.eql(u8, pge.code, "25P02")) {
+                    return;
+                }
+            }
+            return err;
+        };
+        defer result.deinit();
+    }
+};
+
+// Reports whether err was caused by a query exceeding its statement_timeout.
+fn isTimeout(conn: *pg.Conn, err: anyerror) bool {
+    if (err != error.PG) {
+        return false;
+    }
+    const pge = conn.err orelse return false;
+    // query_canceled
+    return std.mem.eql(u8, pge.code, "57014");
+}
+
+// Returns error.Timeout in place of err if it was caused by a query exceeding
+// its statement_timeout.
+fn timeoutError(conn: *pg.Conn, err: anytype) @TypeOf(err) || error{Timeout} {
+    return if (isTimeout(conn, err)) error.Timeout else err;
+}
+
+// Hooks for a Querier that does not observe its queries.
+pub const NoHooks = struct {};
+
+// Calls the optional callbacks declared by Hooks around a query:
+//
+//   pub fn beforeQuery(name: []const u8, sql: []const u8) void
+//   pub fn afterQuery(name: []const u8, duration_ns: u64, rows: ?usize, err: ?anyerror) void
+//
+// The name is the name of the query method. Rows is the number of rows
+// returned, or null for methods passing rows to a context. Callbacks that are
+// not declared are not called, and their overhead compiles away.
+fn QueryHook(comptime Hooks: type) type {
+    return struct {
+        name: []const u8,
+        start: if (@hasDecl(Hooks, "afterQuery")) i128 else void,
+
+        fn begin(name: []const u8, sql: []const u8) @This() {
+            if (@hasDecl(Hooks, "beforeQuery")) {
+                Hooks.beforeQuery(name, sql);
+            }
+            return .{
+                .name = name,
+                .start = if (@hasDecl(Hooks, "afterQuery")) std.time.nanoTimestamp() else {},
+            };
+        }
+
+        fn end(self: @This(), rows: ?usize, err: ?anyerror) void {
+            if (@hasDecl(Hooks, "afterQuery")) {
+                const duration: u64 = @intCast(@max(0, std.time.nanoTimestamp() - self.start));
+                Hooks.afterQuery(self.name, duration, rows, err);
+            }
+        }
+    };
+}
+
+pub const ConnQuerierInterface = QuerierInterface(*pg.Conn, NoHooks);
+pub const PoolQuerierInterface = QuerierInterface(*pg.Pool, NoHooks);
+
+// A type erased interface over the methods of Querier(T). It can be backed by
+// a Querier or any other type declaring the same methods, such as a mock.
+// Methods taking a context are not part of the interface.
+pub fn QuerierInterface(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        ptr: *anyopaque,
+        vtable: *const VTable,
+
+        pub const VTable = struct {
+            createInvoice: *const fn (ptr: *anyopaque, create_invoice_params: Querier(T, Hooks).CreateInvoiceParams) anyerror!Querier(T, Hooks).CreateInvoiceResult,
+            getInvoiceStatus: *const fn (ptr: *anyopaque, id: i32) anyerror!Querier(T, Hooks).GetInvoiceStatusResult,
+            getInvoices: *const fn (ptr: *anyopaque) anyerror!Querier(T, Hooks).GetInvoicesResult,
+        };
+
+        // Returns an interface calling the methods of impl.
+        pub fn init(comptime Impl: type, impl: *Impl) Self {
+            return .{ .ptr = impl, .vtable = &VTableFor(Impl).vtable };
+        }
+
+        fn VTableFor(comptime Impl: type) type {
+            return struct {
+                const vtable = VTable{
+                    .createInvoice = struct {
+                        fn call(ptr: *anyopaque, create_invoice_params: Querier(T, Hooks).CreateInvoiceParams) anyerror!Querier(T, Hooks).CreateInvoiceResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.createInvoice(create_invoice_params);
+                        }
+                    }.call,
+                    .getInvoiceStatus = struct {
+                        fn call(ptr: *anyopaque, id: i32) anyerror!Querier(T, Hooks).GetInvoiceStatusResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getInvoiceStatus(id);
+                        }
+                    }.call,
+                    .getInvoices = struct {
+                        fn call(ptr: *anyopaque) anyerror!Querier(T, Hooks).GetInvoicesResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getInvoices();
+                        }
+                    }.call,
+                };
+            };
+        }
+
+        pub fn createInvoice(self: Self, create_invoice_params: Querier(T, Hooks).CreateInvoiceParams) anyerror!Querier(T, Hooks).CreateInvoiceResult {
+            return self.vtable.createInvoice(self.ptr, create_invoice_params);
+        }
+
+        pub fn getInvoiceStatus(self: Self, id: i32) anyerror!Querier(T, Hooks).GetInvoiceStatusResult {
+            return self.vtable.getInvoiceStatus(self.ptr, id);
+        }
+
+        pub fn getInvoices(self: Self) anyerror!Querier(T, Hooks).GetInvoicesResult {
+            return self.vtable.getInvoices(self.ptr);
+        }
+    };
+}
+
+pub const ConnMockQuerier = MockQuerier(*pg.Conn, NoHooks);
+pub const PoolMockQuerier = MockQuerier(*pg.Pool, NoHooks);
+
+// Records the calls to a single MockQuerier method and the result to return.
+pub fn MockMethod(comptime Args: type, comptime Result: type) type {
+    return struct {
+        // The value returned by the method. Methods returning void succeed
+        // without a result.
+        result: ?Result = null,
+        // An error returned by the method instead of the result.
+        err: ?anyerror = null,
+        // Computes the result of the method from its arguments. Takes
+        // precedence over result and err when set.
+        handler: ?*const fn (args: Args) anyerror!Result = null,
+        // The number of calls expected by MockQuerier.verify, or null to
+        // accept any number of calls.
+        expected_calls: ?usize = null,
+        // The number of times the method was called.
+        calls: usize = 0,
+        // The arguments of the most recent call.
+        last_args: ?Args = null,
+
+        fn call(self: *@This(), args: Args) anyerror!Result {
+            self.calls += 1;
+            self.last_args = args;
+            if (self.handler) |handler| {
+                return handler(args);
+            }
+            if (self.err) |err| {
+                return err;
+            }
+            if (Result == void) {
+                return;
+            }
+            return self.result orelse error.UnexpectedCall;
+        }
+    };
+}
+
+// A mock implementation of the methods of Querier(T) for unit tests. Canned
+// results and expectations are set per method on the expect field, calls
+// without a result set return error.UnexpectedCall unless the method returns
+// void.
+pub fn MockQuerier(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        expect: struct {
+            createInvoice: MockMethod(struct { create_invoice_params: Querier(T, Hooks).CreateInvoiceParams }, Querier(T, Hooks).CreateInvoiceResult) = .{},
+            getInvoiceStatus: MockMethod(struct { id: i32 }, Querier(T, Hooks).GetInvoiceStatusResult) = .{},
+            getInvoices: MockMethod(struct {}, Querier(T, Hooks).GetInvoicesResult) = .{},
+        } = .{},
+
+        pub fn interface(self: *Self) QuerierInterface(T, Hooks) {
+            return QuerierInterface(T, Hooks).init(Self, self);
+        }
+
+        // Returns error.UnexpectedCallCount if any method with expected_calls
+        // set was called a different number of times.
+        pub fn verify(self: Self) !void {
+            inline for (std.meta.fields(@TypeOf(self.expect))) |field| {
+                const method = @field(self.expect, field.name);
+                if (method.expected_calls) |expected| {
+                    if (method.calls != expected) {
+                        return error.UnexpectedCallCount;
+                    }
+                }
+            }
+        }
+
+        pub fn createInvoice(self: *Self, create_invoice_params: Querier(T, Hooks).CreateInvoiceParams) anyerror!Querier(T, Hooks).CreateInvoiceResult {
+            return self.expect.createInvoice.call(.{ .create_invoice_params = create_invoice_params });
+        }
+
+        pub fn getInvoiceStatus(self: *Self, id: i32) anyerror!Querier(T, Hooks).GetInvoiceStatusResult {
+            return self.expect.getInvoiceStatus.call(.{ .id = id });
+        }
+
+        pub fn getInvoices(self: *Self) anyerror!Querier(T, Hooks).GetInvoicesResult {
+            return self.expect.getInvoices.call(.{});
+        }
+    };
+}
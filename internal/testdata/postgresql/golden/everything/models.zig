@@ -0,0 +1,769 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+
+pub const Product = enum {
+    @"laptop",
+    @"desktop",
+    @"mobile",
+    @"tablet",
+};
+
+pub const UserRole = enum {
+    @"admin",
+    @"user",
+};
+
+pub const ApiKey = struct {
+    __allocator: Allocator,
+
+    id: [16]u8,
+    user_id: i32,
+    secret: []u8,
+    fingerprints: [][]u8,
+    created_at: i64,
+    expires_at: ?i64 = null,
+
+    pub fn deinit(self: *const ApiKey) void {
+        self.__allocator.free(self.id);
+        self.__allocator.free(self.secret);
+        for (self.fingerprints) |item| {
+            self.__allocator.free(item);
+        }
+        self.__allocator.free(self.fingerprints);
+    }
+
+    pub fn jsonStringify(self: @This(), jw: anytype) !void {
+        try jw.beginObject();
+        try jw.objectField("id");
+        try json_format.uuid(jw, self.id);
+        try jw.objectField("user_id");
+        try jw.write(self.user_id);
+        try jw.objectField("secret");
+        try json_format.bytes(jw, self.secret);
+        try jw.objectField("fingerprints");
+        try json_format.array(jw, self.fingerprints, json_format.bytes);
+        try jw.objectField("created_at");
+        try json_format.timestamp(jw, self.created_at);
+        try jw.objectField("expires_at");
+        if (self.expires_at) |value| {
+            try json_format.timestamp(jw, value);
+        } else {
+            try jw.write(null);
+        }
+        try jw.endObject();
+    }
+
+    pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+        _ = fmt;
+        _ = options;
+        try writer.writeAll("ApiKey{ .id = ");
+        try debug_format.uuid(writer, self.id);
+        try writer.writeAll(", .user_id = ");
+        try debug_format.any(writer, self.user_id);
+        try writer.writeAll(", .secret = ");
+        try debug_format.bytes(writer, self.secret);
+        try writer.writeAll(", .fingerprints = ");
+        try debug_format.array(writer, self.fingerprints, debug_format.bytes);
+        try writer.writeAll(", .created_at = ");
+        try debug_format.timestamp(writer, self.created_at);
+        try writer.writeAll(", .expires_at = ");
+        if (self.expires_at) |value| {
+            try debug_format.timestamp(writer, value);
+        } else {
+            try writer.writeAll("null");
+        }
+        try writer.writeAll(" }");
+    }
+
+    // Returns a deep copy of the struct owned by allocator, to be freed with
+    // deinit.
+    pub fn clone(self: @This(), allocator: Allocator) !@This() {
+        return deep.clone(allocator, self);
+    }
+
+    // Reports whether all fields of the structs hold equal values.
+    pub fn eql(self: @This(), other: @This()) bool {
+        return deep.eql(self, other);
+    }
+
+    // Hashes the values of all fields, consistent with eql.
+    pub fn hash(self: @This()) u64 {
+        var hasher = std.hash.Wyhash.init(0);
+        deep.hash(&hasher, self);
+        return hasher.final();
+    }
+
+    // The table the struct is read from and its columns, in the order of the
+    // struct fields.
+    pub const metadata = struct {
+        pub const table_name = "api_keys";
+        pub const schema = "public";
+        pub const columns = [_]ColumnMetadata{
+            .{ .name = "id", .field = "id", .sql_type = "uuid", .nullable = false, .array = false, .primary_key = true },
+            .{ .name = "user_id", .field = "user_id", .sql_type = "int4", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "secret", .field = "secret", .sql_type = "bytea", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "fingerprints", .field = "fingerprints", .sql_type = "bytea", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "created_at", .field = "created_at", .sql_type = "timestamptz", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "expires_at", .field = "expires_at", .sql_type = "timestamptz", .nullable = true, .array = false, .primary_key = false },
+        };
+
+        // Returns the metadata of the column held by a struct field.
+        pub fn column(comptime field: []const u8) ColumnMetadata {
+            return comptime for (columns) |c| {
+                if (std.mem.eql(u8, c.field, field)) break c;
+            } else @compileError("no column is held by field " ++ field);
+        }
+    };
+};
+
+pub const Order = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    order_date: i64,
+    item_ids: []i32,
+    products: []const Product,
+    item_quantities: []pg.Numeric,
+    shipping_addresses: [][]const u8,
+    ip_addresses: []pg.Cidr,
+    total_amount: pg.Numeric,
+
+    pub fn deinit(self: *const Order) void {
+        self.__allocator.free(self.item_ids);
+        self.__allocator.free(self.products);
+        for (self.item_quantities) |item| {
+            self.__allocator.free(item.digits);
+        }
+        self.__allocator.free(self.item_quantities);
+        for (self.shipping_addresses) |item| {
+            self.__allocator.free(item);
+        }
+        self.__allocator.free(self.shipping_addresses);
+        for (self.ip_addresses) |item| {
+            self.__allocator.free(item.address);
+        }
+        self.__allocator.free(self.ip_addresses);
+        self.__allocator.free(self.total_amount.digits);
+    }
+
+    pub fn jsonStringify(self: @This(), jw: anytype) !void {
+        try jw.beginObject();
+        try jw.objectField("id");
+        try jw.write(self.id);
+        try jw.objectField("order_date");
+        try json_format.timestamp(jw, self.order_date);
+        try jw.objectField("item_ids");
+        try jw.write(self.item_ids);
+        try jw.objectField("products");
+        try jw.write(self.products);
+        try jw.objectField("item_quantities");
+        try jw.write(self.item_quantities);
+        try jw.objectField("shipping_addresses");
+        try jw.write(self.shipping_addresses);
+        try jw.objectField("ip_addresses");
+        try jw.write(self.ip_addresses);
+        try jw.objectField("total_amount");
+        try jw.write(self.total_amount);
+        try jw.endObject();
+    }
+
+    pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+        _ = fmt;
+        _ = options;
+        try writer.writeAll("Order{ .id = ");
+        try debug_format.any(writer, self.id);
+        try writer.writeAll(", .order_date = ");
+        try debug_format.timestamp(writer, self.order_date);
+        try writer.writeAll(", .item_ids = ");
+        try debug_format.array(writer, self.item_ids, debug_format.any);
+        try writer.writeAll(", .products = ");
+        try debug_format.array(writer, self.products, debug_format.@"enum");
+        try writer.writeAll(", .item_quantities = ");
+        try debug_format.array(writer, self.item_quantities, debug_format.numeric);
+        try writer.writeAll(", .shipping_addresses = ");
+        try debug_format.array(writer, self.shipping_addresses, debug_format.string);
+        try writer.writeAll(", .ip_addresses = ");
+        try debug_format.array(writer, self.ip_addresses, debug_format.cidr);
+        try writer.writeAll(", .total_amount = ");
+        try debug_format.numeric(writer, self.total_amount);
+        try writer.writeAll(" }");
+    }
+
+    // Returns a deep copy of the struct owned by allocator, to be freed with
+    // deinit.
+    pub fn clone(self: @This(), allocator: Allocator) !@This() {
+        return deep.clone(allocator, self);
+    }
+
+    // Reports whether all fields of the structs hold equal values.
+    pub fn eql(self: @This(), other: @This()) bool {
+        return deep.eql(self, other);
+    }
+
+    // Hashes the values of all fields, consistent with eql.
+    pub fn hash(self: @This()) u64 {
+        var hasher = std.hash.Wyhash.init(0);
+        deep.hash(&hasher, self);
+        return hasher.final();
+    }
+
+    // The table the struct is read from and its columns, in the order of the
+    // struct fields.
+    pub const metadata = struct {
+        pub const table_name = "orders";
+        pub const schema = "public";
+        pub const columns = [_]ColumnMetadata{
+            .{ .name = "id", .field = "id", .sql_type = "serial", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "order_date", .field = "order_date", .sql_type = "timestamp", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "item_ids", .field = "item_ids", .sql_type = "int4", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "products", .field = "products", .sql_type = "product", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "item_quantities", .field = "item_quantities", .sql_type = "numeric", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "shipping_addresses", .field = "shipping_addresses", .sql_type = "text", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "ip_addresses", .field = "ip_addresses", .sql_type = "inet", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "total_amount", .field = "total_amount", .sql_type = "numeric", .nullable = false, .array = false, .primary_key = false },
+        };
+
+        // Returns the metadata of the column held by a struct field.
+        pub fn column(comptime field: []const u8) ColumnMetadata {
+            return comptime for (columns) |c| {
+                if (std.mem.eql(u8, c.field, field)) break c;
+            } else @compileError("no column is held by field " ++ field);
+        }
+    };
+};
+
+pub const User = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    name: []const u8,
+    email: []const u8,
+    password: []const u8,
+    role: UserRole,
+    ip_address: ?pg.Cidr = null,
+    salary: ?pg.Numeric = null,
+    notes: ?[]const u8 = null,
+    created_at: i64,
+    updated_at: i64,
+    archived_at: ?i64 = null,
+
+    pub fn deinit(self: *const User) void {
+        self.__allocator.free(self.name);
+        self.__allocator.free(self.email);
+        self.__allocator.free(self.password);
+        if (self.ip_address) |field| {
+            self.__allocator.free(field.address);
+        }
+        if (self.salary) |field| {
+            self.__allocator.free(field.digits);
+        }
+        if (self.notes) |field| {
+            self.__allocator.free(field);
+        }
+    }
+
+    pub fn jsonStringify(self: @This(), jw: anytype) !void {
+        try jw.beginObject();
+        try jw.objectField("id");
+        try jw.write(self.id);
+        try jw.objectField("name");
+        try jw.write(self.name);
+        try jw.objectField("email");
+        try jw.write(self.email);
+        try jw.objectField("password");
+        try jw.write(self.password);
+        try jw.objectField("role");
+        try jw.write(self.role);
+        try jw.objectField("ip_address");
+        try jw.write(self.ip_address);
+        try jw.objectField("salary");
+        try jw.write(self.salary);
+        try jw.objectField("notes");
+        try jw.write(self.notes);
+        try jw.objectField("created_at");
+        try json_format.timestamp(jw, self.created_at);
+        try jw.objectField("updated_at");
+        try json_format.timestamp(jw, self.updated_at);
+        try jw.objectField("archived_at");
+        if (self.archived_at) |value| {
+            try json_format.timestamp(jw, value);
+        } else {
+            try jw.write(null);
+        }
+        try jw.endObject();
+    }
+
+    pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+        _ = fmt;
+        _ = options;
+        try writer.writeAll("User{ .id = ");
+        try debug_format.any(writer, self.id);
+        try writer.writeAll(", .name = ");
+        try debug_format.string(writer, self.name);
+        try writer.writeAll(", .email = ");
+        try debug_format.string(writer, self.email);
+        try writer.writeAll(", .password = <redacted>");
+        try writer.writeAll(", .role = ");
+        try debug_format.@"enum"(writer, self.role);
+        try writer.writeAll(", .ip_address = ");
+        if (self.ip_address) |value| {
+            try debug_format.cidr(writer, value);
+        } else {
+            try writer.writeAll("null");
+        }
+        try writer.writeAll(", .salary = ");
+        if (self.salary) |value| {
+            try debug_format.numeric(writer, value);
+        } else {
+            try writer.writeAll("null");
+        }
+        try writer.writeAll(", .notes = ");
+        if (self.notes) |value| {
+            try debug_format.string(writer, value);
+        } else {
+            try writer.writeAll("null");
+        }
+        try writer.writeAll(", .created_at = ");
+        try debug_format.timestamp(writer, self.created_at);
+        try writer.writeAll(", .updated_at = ");
+        try debug_format.timestamp(writer, self.updated_at);
+        try writer.writeAll(", .archived_at = ");
+        if (self.archived_at) |value| {
+            try debug_format.timestamp(writer, value);
+        } else {
+            try writer.writeAll("null");
+        }
+        try writer.writeAll(" }");
+    }
+
+    // Returns a deep copy of the struct owned by allocator, to be freed with
+    // deinit.
+    pub fn clone(self: @This(), allocator: Allocator) !@This() {
+        return deep.clone(allocator, self);
+    }
+
+    // Reports whether all fields of the structs hold equal values.
+    pub fn eql(self: @This(), other: @This()) bool {
+        return deep.eql(self, other);
+    }
+
+    // Hashes the values of all fields, consistent with eql.
+    pub fn hash(self: @This()) u64 {
+        var hasher = std.hash.Wyhash.init(0);
+        deep.hash(&hasher, self);
+        return hasher.final();
+    }
+
+    // The table the struct is read from and its columns, in the order of the
+    // struct fields.
+    pub const metadata = struct {
+        pub const table_name = "users";
+        pub const schema = "public";
+        pub const columns = [_]ColumnMetadata{
+            .{ .name = "id", .field = "id", .sql_type = "serial", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "name", .field = "name", .sql_type = "text", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "email", .field = "email", .sql_type = "text", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "password", .field = "password", .sql_type = "text", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "role", .field = "role", .sql_type = "user_role", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "ip_address", .field = "ip_address", .sql_type = "inet", .nullable = true, .array = false, .primary_key = false },
+            .{ .name = "salary", .field = "salary", .sql_type = "numeric", .nullable = true, .array = false, .primary_key = false },
+            .{ .name = "notes", .field = "notes", .sql_type = "text", .nullable = true, .array = false, .primary_key = false },
+            .{ .name = "created_at", .field = "created_at", .sql_type = "timestamp", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "updated_at", .field = "updated_at", .sql_type = "timestamp", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "archived_at", .field = "archived_at", .sql_type = "timestamp", .nullable = true, .array = false, .primary_key = false },
+        };
+
+        // Returns the metadata of the column held by a struct field.
+        pub fn column(comptime field: []const u8) ColumnMetadata {
+            return comptime for (columns) |c| {
+                if (std.mem.eql(u8, c.field, field)) break c;
+            } else @compileError("no column is held by field " ++ field);
+        }
+    };
+};
+
+pub const billing = struct {
+    pub const InvoiceStatus = enum {
+        @"draft",
+        @"paid",
+    };
+
+    pub const Invoice = struct {
+        __allocator: Allocator,
+
+        id: i32,
+        user_id: i32,
+        status: billing.InvoiceStatus,
+        amount: pg.Numeric,
+        memo: ?[]const u8 = null,
+
+        pub fn deinit(self: *const Invoice) void {
+            self.__allocator.free(self.amount.digits);
+            if (self.memo) |field| {
+                self.__allocator.free(field);
+            }
+        }
+
+        pub fn jsonStringify(self: @This(), jw: anytype) !void {
+            try jw.beginObject();
+            try jw.objectField("id");
+            try jw.write(self.id);
+            try jw.objectField("user_id");
+            try jw.write(self.user_id);
+            try jw.objectField("status");
+            try jw.write(self.status);
+            try jw.objectField("amount");
+            try jw.write(self.amount);
+            try jw.objectField("memo");
+            try jw.write(self.memo);
+            try jw.endObject();
+        }
+
+        pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+            _ = fmt;
+            _ = options;
+            try writer.writeAll("Invoice{ .id = ");
+            try debug_format.any(writer, self.id);
+            try writer.writeAll(", .user_id = ");
+            try debug_format.any(writer, self.user_id);
+            try writer.writeAll(", .status = ");
+            try debug_format.@"enum"(writer, self.status);
+            try writer.writeAll(", .amount = ");
+            try debug_format.numeric(writer, self.amount);
+            try writer.writeAll(", .memo = ");
+            if (self.memo) |value| {
+                try debug_format.string(writer, value);
+            } else {
+                try writer.writeAll("null");
+            }
+            try writer.writeAll(" }");
+        }
+
+        // Returns a deep copy of the struct owned by allocator, to be freed with
+        // deinit.
+        pub fn clone(self: @This(), allocator: Allocator) !@This() {
+            return deep.clone(allocator, self);
+        }
+
+        // Reports whether all fields of the structs hold equal values.
+        pub fn eql(self: @This(), other: @This()) bool {
+            return deep.eql(self, other);
+        }
+
+        // Hashes the values of all fields, consistent with eql.
+        pub fn hash(self: @This()) u64 {
+            var hasher = std.hash.Wyhash.init(0);
+            deep.hash(&hasher, self);
+            return hasher.final();
+        }
+
+        // The table the struct is read from and its columns, in the order of the
+        // struct fields.
+        pub const metadata = struct {
+            pub const table_name = "invoices";
+            pub const schema = "billing";
+            pub const columns = [_]ColumnMetadata{
+                .{ .name = "id", .field = "id", .sql_type = "serial", .nullable = false, .array = false, .primary_key = false },
+                .{ .name = "user_id", .field = "user_id", .sql_type = "int4", .nullable = false, .array = false, .primary_key = false },
+                .{ .name = "status", .field = "status", .sql_type = "billing.invoice_status", .nullable = false, .array = false, .primary_key = false },
+                .{ .name = "amount", .field = "amount", .sql_type = "numeric", .nullable = false, .array = false, .primary_key = false },
+                .{ .name = "memo", .field = "memo", .sql_type = "text", .nullable = true, .array = false, .primary_key = false },
+            };
+
+            // Returns the metadata of the column held by a struct field.
+            pub fn column(comptime field: []const u8) ColumnMetadata {
+                return comptime for (columns) |c| {
+                    if (std.mem.eql(u8, c.field, field)) break c;
+                } else @compileError("no column is held by field " ++ field);
+            }
+        };
+    };
+};
+
+// Writes values that std.json cannot write as is in generated jsonStringify
+// methods.
+const json_format = struct {
+    // Writes binary data as a base64 encoded string.
+    fn bytes(jw: anytype, value: []const u8) !void {
+        try jw.beginWriteRaw();
+        try jw.stream.writeByte('"');
+        try std.base64.standard.Encoder.encodeWriter(jw.stream, value);
+        try jw.stream.writeByte('"');
+        jw.endWriteRaw();
+    }
+
+    // Writes a UUID in its canonical form, e.g.
+    // "123e4567-e89b-12d3-a456-426614174000".
+    fn uuid(jw: anytype, value: [16]u8) !void {
+        const hex = "0123456789abcdef";
+        var buf: [36]u8 = undefined;
+        var i: usize = 0;
+        for (value, 0..) |byte, idx| {
+            if (idx == 4 or idx == 6 or idx == 8 or idx == 10) {
+                buf[i] = '-';
+                i += 1;
+            }
+            buf[i] = hex[byte >> 4];
+            buf[i + 1] = hex[byte & 0x0f];
+            i += 2;
+        }
+        try jw.write(buf[0..]);
+    }
+
+    // Writes a timestamp in microseconds since the Unix epoch as an RFC 3339
+    // string in UTC, e.g. "2024-01-02T03:04:05.000006Z". Timestamps before the
+    // epoch are written as numbers.
+    fn timestamp(jw: anytype, value: i64) !void {
+        if (value < 0) {
+            return jw.write(value);
+        }
+        try jw.beginWriteRaw();
+        try jw.stream.writeByte('"');
+        try printTimestamp(jw.stream, @intCast(value));
+        try jw.stream.writeByte('"');
+        jw.endWriteRaw();
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn array(jw: anytype, values: anytype, comptime writeValue: anytype) !void {
+        try jw.beginArray();
+        for (values) |value| {
+            try writeValue(jw, value);
+        }
+        try jw.endArray();
+    }
+};
+
+// Describes a column of the table a model is read from.
+pub const ColumnMetadata = struct {
+    // The name of the column in SQL
+    name: []const u8,
+    // The name of the struct field holding the column
+    field: []const u8,
+    // The SQL type of the column, or of the items of array columns
+    sql_type: []const u8,
+    nullable: bool,
+    array: bool,
+    primary_key: bool,
+};
+
+// Walks the fields of generated structs for the clone, eql and hash methods.
+// Slices are followed and the internal allocator field is skipped.
+const deep = struct {
+    fn clone(allocator: Allocator, value: anytype) !@TypeOf(value) {
+        const T = @TypeOf(value);
+        switch (@typeInfo(T)) {
+            .optional => return if (value) |v| try clone(allocator, v) else null,
+            .pointer => |ptr| {
+                switch (@typeInfo(ptr.child)) {
+                    .optional, .pointer, .@"struct" => {},
+                    else => return allocator.dupe(ptr.child, value),
+                }
+                const out = try allocator.alloc(ptr.child, value.len);
+                errdefer allocator.free(out);
+                var cloned: usize = 0;
+                errdefer for (out[0..cloned]) |item| free(allocator, item);
+                for (value) |item| {
+                    out[cloned] = try clone(allocator, item);
+                    cloned += 1;
+                }
+                return out;
+            },
+            .@"struct" => |info| {
+                var out = value;
+                var cloned: usize = 0;
+                errdefer inline for (info.fields, 0..) |field, i| {
+                    if (i < cloned and !comptime isAllocator(field.name)) {
+                        free(allocator, @field(out, field.name));
+                    }
+                }
+                inline for (info.fields) |field| {
+                    if (comptime isAllocator(field.name)) {
+                        out.__allocator = allocator;
+                    } else {
+                        @field(out, field.name) = try clone(allocator, @field(value, field.name));
+                    }
+                    cloned += 1;
+                }
+                return out;
+            },
+            else => return value,
+        }
+    }
+
+    fn free(allocator: Allocator, value: anytype) void {
+        switch (@typeInfo(@TypeOf(value))) {
+            .optional => if (value) |v| free(allocator, v),
+            .pointer => {
+                for (value) |item| free(allocator, item);
+                allocator.free(value);
+            },
+            .@"struct" => |info| inline for (info.fields) |field| {
+                if (!comptime isAllocator(field.name)) {
+                    free(allocator, @field(value, field.name));
+                }
+            },
+            else => {},
+        }
+    }
+
+    fn eql(a: anytype, b: @TypeOf(a)) bool {
+        switch (@typeInfo(@TypeOf(a))) {
+            .optional => {
+                const x = a orelse return b == null;
+                const y = b orelse return false;
+                return eql(x, y);
+            },
+            .pointer => {
+                if (a.len != b.len) {
+                    return false;
+                }
+                for (a, b) |x, y| {
+                    if (!eql(x, y)) {
+                        return false;
+                    }
+                }
+                return true;
+            },
+            .array => return std.mem.eql(@typeInfo(@TypeOf(a)).array.child, &a, &b),
+            .@"struct" => |info| {
+                inline for (info.fields) |field| {
+                    if (!comptime isAllocator(field.name) and !eql(@field(a, field.name), @field(b, field.name))) {
+                        return false;
+                    }
+                }
+                return true;
+            },
+            else => return a == b,
+        }
+    }
+
+    fn hash(hasher: *std.hash.Wyhash, value: anytype) void {
+        switch (@typeInfo(@TypeOf(value))) {
+            .optional => {
+                hasher.update(&[_]u8{@intFromBool(value != null)});
+                if (value) |v| hash(hasher, v);
+            },
+            .pointer => {
+                hasher.update(std.mem.asBytes(&value.len));
+                for (value) |item| hash(hasher, item);
+            },
+            .@"struct" => |info| inline for (info.fields) |field| {
+                if (!comptime isAllocator(field.name)) {
+                    hash(hasher, @field(value, field.name));
+                }
+            },
+            else => hasher.update(std.mem.asBytes(&value)),
+        }
+    }
+
+    fn isAllocator(comptime name: []const u8) bool {
+        return std.mem.eql(u8, name, "__allocator");
+    }
+};
+
+// Prints the fields of generated structs in format methods.
+const debug_format = struct {
+    fn any(writer: anytype, value: anytype) !void {
+        try writer.print("{any}", .{value});
+    }
+
+    fn string(writer: anytype, value: []const u8) !void {
+        try writer.print("\"{}\"", .{std.zig.fmtEscapes(value)});
+    }
+
+    fn bytes(writer: anytype, value: []const u8) !void {
+        try writer.print("0x{}", .{std.fmt.fmtSliceHexLower(value)});
+    }
+
+    fn uuid(writer: anytype, value: [16]u8) !void {
+        try writer.print("{}-{}-{}-{}-{}", .{
+            std.fmt.fmtSliceHexLower(value[0..4]),
+            std.fmt.fmtSliceHexLower(value[4..6]),
+            std.fmt.fmtSliceHexLower(value[6..8]),
+            std.fmt.fmtSliceHexLower(value[8..10]),
+            std.fmt.fmtSliceHexLower(value[10..16]),
+        });
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC, e.g.
+    // 2024-01-02T03:04:05.000006Z. Timestamps before the epoch are printed as
+    // numbers.
+    fn timestamp(writer: anytype, value: i64) !void {
+        if (value < 0) {
+            return writer.print("{d}", .{value});
+        }
+        try printTimestamp(writer, @intCast(value));
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn @"enum"(writer: anytype, value: anytype) !void {
+        try writer.print(".{s}", .{@tagName(value)});
+    }
+
+    fn cidr(writer: anytype, value: pg.Cidr) !void {
+        if (value.address.len == 4) {
+            try writer.print("{d}.{d}.{d}.{d}", .{ value.address[0], value.address[1], value.address[2], value.address[3] });
+        } else {
+            var i: usize = 0;
+            while (i + 1 < value.address.len) : (i += 2) {
+                if (i > 0) {
+                    try writer.writeByte(':');
+                }
+                try writer.print("{x}", .{@as(u16, value.address[i]) << 8 | value.address[i + 1]});
+            }
+        }
+        try writer.print("/{d}", .{value.netmask});
+    }
+
+    fn numeric(writer: anytype, value: pg.Numeric) !void {
+        try writer.print("{d}", .{value.toFloat()});
+    }
+
+    fn array(writer: anytype, values: anytype, comptime formatValue: anytype) !void {
+        try writer.writeAll("{ ");
+        for (values, 0..) |value, i| {
+            if (i > 0) {
+                try writer.writeAll(", ");
+            }
+            try formatValue(writer, value);
+        }
+        try writer.writeAll(" }");
+    }
+};
@@ -0,0 +1,1392 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+const catalog = @import("queries.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn, NoHooks);
+pub const PoolQuerier = Querier(*pg.Pool, NoHooks);
+
+pub fn Querier(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(T, Hooks) {
+            return QuerierInterface(T, Hooks).init(Self, self);
+        }
+        
+        const create_order_sql = 
+            \\INSERT INTO orders (
+            \\    order_date,
+            \\    item_ids,
+            \\    products,
+            \\    item_quantities,
+            \\    shipping_addresses,
+            \\    ip_addresses,
+            \\    total_amount
+            \\) VALUES (
+            \\    $1,
+            \\    $2,
+            \\    $3,
+            \\    $4,
+            \\    $5,
+            \\    $6,
+            \\    $7
+            \\)
+        ;
+
+        pub const CreateOrderParams = struct {
+            order_date: i64,
+            item_ids: []i32,
+            products: []const models.Product,
+            item_quantities: []f64,
+            shipping_addresses: [][]const u8,
+            ip_addresses: [][]const u8,
+            total_amount: f64,
+
+            pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+                _ = fmt;
+                _ = options;
+                try writer.writeAll("CreateOrderParams{ .order_date = ");
+                try debug_format.timestamp(writer, self.order_date);
+                try writer.writeAll(", .item_ids = ");
+                try debug_format.array(writer, self.item_ids, debug_format.any);
+                try writer.writeAll(", .products = ");
+                try debug_format.array(writer, self.products, debug_format.@"enum");
+                try writer.writeAll(", .item_quantities = ");
+                try debug_format.array(writer, self.item_quantities, debug_format.any);
+                try writer.writeAll(", .shipping_addresses = ");
+                try debug_format.array(writer, self.shipping_addresses, debug_format.string);
+                try writer.writeAll(", .ip_addresses = ");
+                try debug_format.array(writer, self.ip_addresses, debug_format.string);
+                try writer.writeAll(", .total_amount = ");
+                try debug_format.any(writer, self.total_amount);
+                try writer.writeAll(" }");
+            }
+        };
+
+        pub const CreateOrderResult = union(enum) {
+            ok: void,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .ok => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn createOrder(self: Self, create_order_params: CreateOrderParams) !CreateOrderResult {
+            const hook = QueryHook(Hooks).begin("createOrder", create_order_sql);
+            const result = self.createOrderUnhooked(create_order_params);
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(0, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn createOrderUnhooked(self: Self, create_order_params: CreateOrderParams) !CreateOrderResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.createOrderUntimed(conn, create_order_params);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .ok => {},
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn createOrderUntimed(self: Self, conn: *pg.Conn, create_order_params: CreateOrderParams) !CreateOrderResult {
+            const allocator = self.allocator;
+            _ = conn.exec(create_order_sql, .{ 
+                create_order_params.order_date,
+                create_order_params.item_ids,
+                create_order_params.products,
+                create_order_params.item_quantities,
+                create_order_params.shipping_addresses,
+                create_order_params.ip_addresses,
+                create_order_params.total_amount,
+            }) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            return .{ .ok = undefined };
+        }
+
+        const get_order_by_id_sql = 
+            \\SELECT id, order_date, item_ids, products, item_quantities, shipping_addresses, ip_addresses, total_amount FROM orders
+            \\WHERE id = $1 LIMIT 1
+        ;
+
+        pub const GetOrderByIDResult = union(enum) {
+            order: models.Order,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .order => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getOrderByID(self: Self, id: i32) !GetOrderByIDResult {
+            const hook = QueryHook(Hooks).begin("getOrderByID", get_order_by_id_sql);
+            const result = self.getOrderByIDUnhooked(id);
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(1, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getOrderByIDUnhooked(self: Self, id: i32) !GetOrderByIDResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.getOrderByIDUntimed(conn, id);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .order => |rows| {
+                        rows.deinit();
+                    },
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn getOrderByIDUntimed(self: Self, conn: *pg.Conn, id: i32) !GetOrderByIDResult {
+            const allocator = self.allocator;
+            const result = conn.query(get_order_by_id_sql, .{ 
+                id,
+            }) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            const row = (result.next() catch |err| return timeoutError(conn, err)) orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+            const row_order_date = row.get(i64, 1);
+            var row_item_ids = std.ArrayList(i32).init(allocator);
+            defer row_item_ids.deinit();
+            var row_item_ids_iter = row.get(pg.Iterator(i32), 2);
+            while (row_item_ids_iter.next()) |item| {
+                try row_item_ids.append(item);
+            }
+            var row_products = std.ArrayList(models.Product).init(allocator);
+            defer row_products.deinit();
+            var row_products_iter = row.get(pg.Iterator([]const u8), 3);
+            while (row_products_iter.next()) |item| {
+                try row_products.append(std.meta.stringToEnum(models.Product, item) orelse unreachable);
+            }
+            var row_item_quantities = std.ArrayList(pg.Numeric).init(allocator);
+            defer row_item_quantities.deinit();
+            var row_item_quantities_iter = row.get(pg.Iterator(pg.Numeric), 4);
+            while (row_item_quantities_iter.next()) |item| {
+                const digits = try allocator.dupe(u8, item.digits);
+                errdefer allocator.free(digits);
+                try row_item_quantities.append(pg.Numeric{
+                    .number_of_digits = item.number_of_digits,
+                    .weight = item.weight,
+                    .sign = item.sign,
+                    .scale = item.scale,
+                    .digits = digits,
+                });
+            }
+            var row_shipping_addresses = std.ArrayList([]const u8).init(allocator);
+            defer row_shipping_addresses.deinit();
+            var row_shipping_addresses_iter = row.get(pg.Iterator([]const u8), 5);
+            while (row_shipping_addresses_iter.next()) |item| {
+                const value = try allocator.dupe(u8, item);
+                errdefer allocator.free(value);
+                try row_shipping_addresses.append(value);
+            }
+            var row_ip_addresses = std.ArrayList(pg.Cidr).init(allocator);
+            defer row_ip_addresses.deinit();
+            var row_ip_addresses_iter = row.get(pg.Iterator(pg.Cidr), 6);
+            while (row_ip_addresses_iter.next()) |item| {
+                const address = try allocator.dupe(u8, item.address);
+                errdefer allocator.free(address);
+                try row_ip_addresses.append(pg.Cidr{
+                    .address = address,
+                    .netmask = item.netmask,
+                    .family = item.family,
+                });
+            }
+            const total_amount_numeric = row.get(pg.Numeric, 7);const row_total_amount = pg.Numeric{
+                .number_of_digits = total_amount_numeric.number_of_digits,
+                .weight = total_amount_numeric.weight,
+                .sign = total_amount_numeric.sign,
+                .scale = total_amount_numeric.scale,
+                .digits = try allocator.dupe(u8, total_amount_numeric.digits),
+            };
+            errdefer allocator.free(row_total_amount.digits);
+
+            return .{
+                .order = .{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .order_date = row_order_date,
+                    .item_ids = try row_item_ids.toOwnedSlice(),
+                    .products = try row_products.toOwnedSlice(),
+                    .item_quantities = try row_item_quantities.toOwnedSlice(),
+                    .shipping_addresses = try row_shipping_addresses.toOwnedSlice(),
+                    .ip_addresses = try row_ip_addresses.toOwnedSlice(),
+                    .total_amount = row_total_amount,
+                }
+            };
+        }
+
+        const get_order_partial_sql = 
+            \\SELECT order_date, total_amount, products FROM orders
+        ;
+
+        pub const GetOrderPartialRow = struct {
+            __allocator: Allocator,
+
+            order_date: i64,
+            total_amount: pg.Numeric,
+            products: []const models.Product,
+
+            pub fn deinit(self: *const GetOrderPartialRow) void {
+                self.__allocator.free(self.total_amount.digits);
+            }
+
+            pub fn jsonStringify(self: @This(), jw: anytype) !void {
+                try jw.beginObject();
+                try jw.objectField("order_date");
+                try json_format.timestamp(jw, self.order_date);
+                try jw.objectField("total_amount");
+                try jw.write(self.total_amount);
+                try jw.objectField("products");
+                try jw.write(self.products);
+                try jw.endObject();
+            }
+
+            pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+                _ = fmt;
+                _ = options;
+                try writer.writeAll("GetOrderPartialRow{ .order_date = ");
+                try debug_format.timestamp(writer, self.order_date);
+                try writer.writeAll(", .total_amount = ");
+                try debug_format.numeric(writer, self.total_amount);
+                try writer.writeAll(", .products = ");
+                try debug_format.array(writer, self.products, debug_format.@"enum");
+                try writer.writeAll(" }");
+            }
+
+            // Returns a deep copy of the struct owned by allocator, to be freed with
+            // deinit.
+            pub fn clone(self: @This(), allocator: Allocator) !@This() {
+                return deep.clone(allocator, self);
+            }
+
+            // Reports whether all fields of the structs hold equal values.
+            pub fn eql(self: @This(), other: @This()) bool {
+                return deep.eql(self, other);
+            }
+
+            // Hashes the values of all fields, consistent with eql.
+            pub fn hash(self: @This()) u64 {
+                var hasher = std.hash.Wyhash.init(0);
+                deep.hash(&hasher, self);
+                return hasher.final();
+            }
+        };
+
+        pub const GetOrderPartialResult = union(enum) {
+            get_order_partial_row_list: []GetOrderPartialRow,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .get_order_partial_row_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getOrderPartial(self: Self) !GetOrderPartialResult {
+            const hook = QueryHook(Hooks).begin("getOrderPartial", get_order_partial_sql);
+            const result = self.getOrderPartialUnhooked();
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(value.get_order_partial_row_list.len, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getOrderPartialUnhooked(self: Self) !GetOrderPartialResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.getOrderPartialUntimed(conn);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .get_order_partial_row_list => |rows| {
+                        self.freeGetOrderPartial(rows);
+                    },
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn getOrderPartialUntimed(self: Self, conn: *pg.Conn) !GetOrderPartialResult {
+            const allocator = self.allocator;
+            const result = conn.query(get_order_partial_sql, .{}) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList(GetOrderPartialRow).init(allocator);
+            defer out.deinit();
+            while (result.next() catch |err| return timeoutError(conn, err)) |row| {
+                const row_order_date = row.get(i64, 0);
+                const total_amount_numeric = row.get(pg.Numeric, 1);const row_total_amount = pg.Numeric{
+                    .number_of_digits = total_amount_numeric.number_of_digits,
+                    .weight = total_amount_numeric.weight,
+                    .sign = total_amount_numeric.sign,
+                    .scale = total_amount_numeric.scale,
+                    .digits = try allocator.dupe(u8, total_amount_numeric.digits),
+                };
+                errdefer allocator.free(row_total_amount.digits);
+                var row_products = std.ArrayList(models.Product).init(allocator);
+                defer row_products.deinit();
+                var row_products_iter = row.get(pg.Iterator([]const u8), 2);
+                while (row_products_iter.next()) |item| {
+                    try row_products.append(std.meta.stringToEnum(models.Product, item) orelse unreachable);
+                }
+                try out.append(.{
+                    .__allocator = allocator,
+                    .order_date = row_order_date,
+                    .total_amount = row_total_amount,
+                    .products = try row_products.toOwnedSlice(),
+                });
+            }
+
+            return .{
+                .get_order_partial_row_list = try out.toOwnedSlice(),
+            };
+        }
+
+        // Frees the rows returned by getOrderPartial along with the slice
+        // holding them.
+        pub fn freeGetOrderPartial(self: Self, rows: []const GetOrderPartialRow) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+        const get_order_totals_sql = 
+            \\SELECT total_amount FROM orders
+        ;
+
+        pub const GetOrderTotalsResult = union(enum) {
+            total_amount_list: []pg.Numeric,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .total_amount_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getOrderTotals(self: Self) !GetOrderTotalsResult {
+            const hook = QueryHook(Hooks).begin("getOrderTotals", get_order_totals_sql);
+            const result = self.getOrderTotalsUnhooked();
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(value.total_amount_list.len, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getOrderTotalsUnhooked(self: Self) !GetOrderTotalsResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.getOrderTotalsUntimed(conn);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .total_amount_list => |rows| {
+                        self.freeGetOrderTotals(rows);
+                    },
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn getOrderTotalsUntimed(self: Self, conn: *pg.Conn) !GetOrderTotalsResult {
+            const allocator = self.allocator;
+            const result = conn.query(get_order_totals_sql, .{}) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList(pg.Numeric).init(allocator);
+            defer out.deinit();
+            while (result.next() catch |err| return timeoutError(conn, err)) |row| {
+                const total_amount_numeric = row.get(pg.Numeric, 0);const row_total_amount = pg.Numeric{
+                    .number_of_digits = total_amount_numeric.number_of_digits,
+                    .weight = total_amount_numeric.weight,
+                    .sign = total_amount_numeric.sign,
+                    .scale = total_amount_numeric.scale,
+                    .digits = try allocator.dupe(u8, total_amount_numeric.digits),
+                };
+                errdefer allocator.free(row_total_amount.digits);
+                try out.append(row_total_amount);
+            }
+
+            return .{
+                .total_amount_list = try out.toOwnedSlice(),
+            };
+        }
+
+        // Frees the rows returned by getOrderTotals along with the slice
+        // holding them.
+        pub fn freeGetOrderTotals(self: Self, rows: []const pg.Numeric) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                allocator.free(row.digits);
+            }
+            allocator.free(rows);
+        }
+
+        const get_orders_sql = 
+            \\SELECT id, order_date, item_ids, products, item_quantities, shipping_addresses, ip_addresses, total_amount FROM orders
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetOrdersResult = union(enum) {
+            order_list: []models.Order,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .order_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getOrders(self: Self) !GetOrdersResult {
+            const hook = QueryHook(Hooks).begin("getOrders", get_orders_sql);
+            const result = self.getOrdersUnhooked();
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(value.order_list.len, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getOrdersUnhooked(self: Self) !GetOrdersResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.getOrdersUntimed(conn);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .order_list => |rows| {
+                        self.freeGetOrders(rows);
+                    },
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn getOrdersUntimed(self: Self, conn: *pg.Conn) !GetOrdersResult {
+            const allocator = self.allocator;
+            const result = conn.query(get_orders_sql, .{}) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList(models.Order).init(allocator);
+            defer out.deinit();
+            while (result.next() catch |err| return timeoutError(conn, err)) |row| {
+                const row_id = row.get(i32, 0);
+                const row_order_date = row.get(i64, 1);
+                var row_item_ids = std.ArrayList(i32).init(allocator);
+                defer row_item_ids.deinit();
+                var row_item_ids_iter = row.get(pg.Iterator(i32), 2);
+                while (row_item_ids_iter.next()) |item| {
+                    try row_item_ids.append(item);
+                }
+                var row_products = std.ArrayList(models.Product).init(allocator);
+                defer row_products.deinit();
+                var row_products_iter = row.get(pg.Iterator([]const u8), 3);
+                while (row_products_iter.next()) |item| {
+                    try row_products.append(std.meta.stringToEnum(models.Product, item) orelse unreachable);
+                }
+                var row_item_quantities = std.ArrayList(pg.Numeric).init(allocator);
+                defer row_item_quantities.deinit();
+                var row_item_quantities_iter = row.get(pg.Iterator(pg.Numeric), 4);
+                while (row_item_quantities_iter.next()) |item| {
+                    const digits = try allocator.dupe(u8, item.digits);
+                    errdefer allocator.free(digits);
+                    try row_item_quantities.append(pg.Numeric{
+                        .number_of_digits = item.number_of_digits,
+                        .weight = item.weight,
+                        .sign = item.sign,
+                        .scale = item.scale,
+                        .digits = digits,
+                    });
+                }
+                var row_shipping_addresses = std.ArrayList([]const u8).init(allocator);
+                defer row_shipping_addresses.deinit();
+                var row_shipping_addresses_iter = row.get(pg.Iterator([]const u8), 5);
+                while (row_shipping_addresses_iter.next()) |item| {
+                    const value = try allocator.dupe(u8, item);
+                    errdefer allocator.free(value);
+                    try row_shipping_addresses.append(value);
+                }
+                var row_ip_addresses = std.ArrayList(pg.Cidr).init(allocator);
+                defer row_ip_addresses.deinit();
+                var row_ip_addresses_iter = row.get(pg.Iterator(pg.Cidr), 6);
+                while (row_ip_addresses_iter.next()) |item| {
+                    const address = try allocator.dupe(u8, item.address);
+                    errdefer allocator.free(address);
+                    try row_ip_addresses.append(pg.Cidr{
+                        .address = address,
+                        .netmask = item.netmask,
+                        .family = item.family,
+                    });
+                }
+                const total_amount_numeric = row.get(pg.Numeric, 7);const row_total_amount = pg.Numeric{
+                    .number_of_digits = total_amount_numeric.number_of_digits,
+                    .weight = total_amount_numeric.weight,
+                    .sign = total_amount_numeric.sign,
+                    .scale = total_amount_numeric.scale,
+                    .digits = try allocator.dupe(u8, total_amount_numeric.digits),
+                };
+                errdefer allocator.free(row_total_amount.digits);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .order_date = row_order_date,
+                    .item_ids = try row_item_ids.toOwnedSlice(),
+                    .products = try row_products.toOwnedSlice(),
+                    .item_quantities = try row_item_quantities.toOwnedSlice(),
+                    .shipping_addresses = try row_shipping_addresses.toOwnedSlice(),
+                    .ip_addresses = try row_ip_addresses.toOwnedSlice(),
+                    .total_amount = row_total_amount,
+                });
+            }
+
+            return .{
+                .order_list = try out.toOwnedSlice(),
+            };
+        }
+
+        // Frees the rows returned by getOrders along with the slice
+        // holding them.
+        pub fn freeGetOrders(self: Self, rows: []const models.Order) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
+
+// Routes queries between a primary and a replica pool. Queries returning rows
+// from a SELECT statement run on the replica, all other queries run on the
+// primary. Queries in a transaction always run on the primary.
+pub fn RoutedQuerier(comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+        const Pool = Querier(*pg.Pool, Hooks);
+        const Conn = Querier(*pg.Conn, Hooks);
+
+        primary: Pool,
+        replica: Pool,
+
+        pub fn init(allocator: Allocator, primary: *pg.Pool, replica: *pg.Pool) Self {
+            return .{
+                .primary = Pool.init(allocator, primary),
+                .replica = Pool.init(allocator, replica),
+            };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(*pg.Pool, Hooks) {
+            return QuerierInterface(*pg.Pool, Hooks).init(Self, self);
+        }
+
+        // Begins a transaction on a connection acquired from the primary pool. The
+        // connection is released by commit or rollback.
+        pub fn begin(self: Self) !Transaction {
+            const conn = try self.primary.conn.acquire();
+            errdefer self.primary.conn.release(conn);
+            try conn.begin();
+            return .{
+                .querier = Conn.init(self.primary.allocator, conn),
+                .pool = self.primary.conn,
+            };
+        }
+
+        pub const Transaction = struct {
+            querier: Conn,
+            pool: *pg.Pool,
+
+            pub fn commit(self: Transaction) !void {
+                defer self.pool.release(self.querier.conn);
+                try self.querier.conn.commit();
+            }
+
+            pub fn rollback(self: Transaction) !void {
+                defer self.pool.release(self.querier.conn);
+                try self.querier.conn.rollback();
+            }
+        };
+
+        pub fn createOrder(self: Self, create_order_params: Pool.CreateOrderParams) !Pool.CreateOrderResult {
+            return self.primary.createOrder(create_order_params);
+        }
+
+        pub fn getOrderByID(self: Self, id: i32) !Pool.GetOrderByIDResult {
+            return self.replica.getOrderByID(id);
+        }
+
+        pub fn getOrderPartial(self: Self) !Pool.GetOrderPartialResult {
+            return self.replica.getOrderPartial();
+        }
+
+        pub fn freeGetOrderPartial(self: Self, rows: []const Pool.GetOrderPartialRow) void {
+            self.primary.freeGetOrderPartial(rows);
+        }
+
+        pub fn getOrderTotals(self: Self) !Pool.GetOrderTotalsResult {
+            return self.replica.getOrderTotals();
+        }
+
+        pub fn freeGetOrderTotals(self: Self, rows: []const pg.Numeric) void {
+            self.primary.freeGetOrderTotals(rows);
+        }
+
+        pub fn getOrders(self: Self) !Pool.GetOrdersResult {
+            return self.replica.getOrders();
+        }
+
+        pub fn freeGetOrders(self: Self, rows: []const models.Order) void {
+            self.primary.freeGetOrders(rows);
+        }
+    };
+}
+
+// The queries of this file, ordered by method name.
+pub const queries = [_]catalog.QueryInfo{
+    .{
+        .name = "createOrder",
+        .sqlc_name = "CreateOrder",
+        .file = "orders.sql",
+        .cmd = ":exec",
+        .sql = ConnQuerier.create_order_sql,
+        .params = &.{
+            .{ .name = "order_date", .sql_type = "timestamp", .nullable = false, .array = false },
+            .{ .name = "item_ids", .sql_type = "int4", .nullable = false, .array = true },
+            .{ .name = "products", .sql_type = "product", .nullable = false, .array = true },
+            .{ .name = "item_quantities", .sql_type = "numeric", .nullable = false, .array = true },
+            .{ .name = "shipping_addresses", .sql_type = "text", .nullable = false, .array = true },
+            .{ .name = "ip_addresses", .sql_type = "inet", .nullable = false, .array = true },
+            .{ .name = "total_amount", .sql_type = "numeric", .nullable = false, .array = false },
+        },
+        .columns = &.{},
+    },
+    .{
+        .name = "getOrderByID",
+        .sqlc_name = "GetOrderByID",
+        .file = "orders.sql",
+        .cmd = ":one",
+        .sql = ConnQuerier.get_order_by_id_sql,
+        .params = &.{
+            .{ .name = "id", .sql_type = "serial", .nullable = false, .array = false },
+        },
+        .columns = &.{ "id", "order_date", "item_ids", "products", "item_quantities", "shipping_addresses", "ip_addresses", "total_amount" },
+    },
+    .{
+        .name = "getOrderPartial",
+        .sqlc_name = "GetOrderPartial",
+        .file = "orders.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_order_partial_sql,
+        .params = &.{},
+        .columns = &.{ "order_date", "total_amount", "products" },
+    },
+    .{
+        .name = "getOrderTotals",
+        .sqlc_name = "GetOrderTotals",
+        .file = "orders.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_order_totals_sql,
+        .params = &.{},
+        .columns = &.{ "total_amount" },
+    },
+    .{
+        .name = "getOrders",
+        .sqlc_name = "GetOrders",
+        .file = "orders.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_orders_sql,
+        .params = &.{},
+        .columns = &.{ "id", "order_date", "item_ids", "products", "item_quantities", "shipping_addresses", "ip_addresses", "total_amount" },
+    },
+};
+
+// Writes values that std.json cannot write as is in generated jsonStringify
+// methods.
+const json_format = struct {
+    // Writes binary data as a base64 encoded string.
+    fn bytes(jw: anytype, value: []const u8) !void {
+        try jw.beginWriteRaw();
+        try jw.stream.writeByte('"');
+        try std.base64.standard.Encoder.encodeWriter(jw.stream, value);
+        try jw.stream.writeByte('"');
+        jw.endWriteRaw();
+    }
+
+    // Writes a UUID in its canonical form, e.g.
+    // "123e4567-e89b-12d3-a456-426614174000".
+    fn uuid(jw: anytype, value: [16]u8) !void {
+        const hex = "0123456789abcdef";
+        var buf: [36]u8 = undefined;
+        var i: usize = 0;
+        for (value, 0..) |byte, idx| {
+            if (idx == 4 or idx == 6 or idx == 8 or idx == 10) {
+                buf[i] = '-';
+                i += 1;
+            }
+            buf[i] = hex[byte >> 4];
+            buf[i + 1] = hex[byte & 0x0f];
+            i += 2;
+        }
+        try jw.write(buf[0..]);
+    }
+
+    // Writes a timestamp in microseconds since the Unix epoch as an RFC 3339
+    // string in UTC, e.g. "2024-01-02T03:04:05.000006Z". Timestamps before the
+    // epoch are written as numbers.
+    fn timestamp(jw: anytype, value: i64) !void {
+        if (value < 0) {
+            return jw.write(value);
+        }
+        try jw.beginWriteRaw();
+        try jw.stream.writeByte('"');
+        try printTimestamp(jw.stream, @intCast(value));
+        try jw.stream.writeByte('"');
+        jw.endWriteRaw();
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn array(jw: anytype, values: anytype, comptime writeValue: anytype) !void {
+        try jw.beginArray();
+        for (values) |value| {
+            try writeValue(jw, value);
+        }
+        try jw.endArray();
+    }
+};
+
+// Walks the fields of generated structs for the clone, eql and hash methods.
+// Slices are followed and the internal allocator field is skipped.
+const deep = struct {
+    fn clone(allocator: Allocator, value: anytype) !@TypeOf(value) {
+        const T = @TypeOf(value);
+        switch (@typeInfo(T)) {
+            .optional => return if (value) |v| try clone(allocator, v) else null,
+            .pointer => |ptr| {
+                switch (@typeInfo(ptr.child)) {
+                    .optional, .pointer, .@"struct" => {},
+                    else => return allocator.dupe(ptr.child, value),
+                }
+                const out = try allocator.alloc(ptr.child, value.len);
+                errdefer allocator.free(out);
+                var cloned: usize = 0;
+                errdefer for (out[0..cloned]) |item| free(allocator, item);
+                for (value) |item| {
+                    out[cloned] = try clone(allocator, item);
+                    cloned += 1;
+                }
+                return out;
+            },
+            .@"struct" => |info| {
+                var out = value;
+                var cloned: usize = 0;
+                errdefer inline for (info.fields, 0..) |field, i| {
+                    if (i < cloned and !comptime isAllocator(field.name)) {
+                        free(allocator, @field(out, field.name));
+                    }
+                }
+                inline for (info.fields) |field| {
+                    if (comptime isAllocator(field.name)) {
+                        out.__allocator = allocator;
+                    } else {
+                        @field(out, field.name) = try clone(allocator, @field(value, field.name));
+                    }
+                    cloned += 1;
+                }
+                return out;
+            },
+            else => return value,
+        }
+    }
+
+    fn free(allocator: Allocator, value: anytype) void {
+        switch (@typeInfo(@TypeOf(value))) {
+            .optional => if (value) |v| free(allocator, v),
+            .pointer => {
+                for (value) |item| free(allocator, item);
+                allocator.free(value);
+            },
+            .@"struct" => |info| inline for (info.fields) |field| {
+                if (!comptime isAllocator(field.name)) {
+                    free(allocator, @field(value, field.name));
+                }
+            },
+            else => {},
+        }
+    }
+
+    fn eql(a: anytype, b: @TypeOf(a)) bool {
+        switch (@typeInfo(@TypeOf(a))) {
+            .optional => {
+                const x = a orelse return b == null;
+                const y = b orelse return false;
+                return eql(x, y);
+            },
+            .pointer => {
+                if (a.len != b.len) {
+                    return false;
+                }
+                for (a, b) |x, y| {
+                    if (!eql(x, y)) {
+                        return false;
+                    }
+                }
+                return true;
+            },
+            .array => return std.mem.eql(@typeInfo(@TypeOf(a)).array.child, &a, &b),
+            .@"struct" => |info| {
+                inline for (info.fields) |field| {
+                    if (!comptime isAllocator(field.name) and !eql(@field(a, field.name), @field(b, field.name))) {
+                        return false;
+                    }
+                }
+                return true;
+            },
+            else => return a == b,
+        }
+    }
+
+    fn hash(hasher: *std.hash.Wyhash, value: anytype) void {
+        switch (@typeInfo(@TypeOf(value))) {
+            .optional => {
+                hasher.update(&[_]u8{@intFromBool(value != null)});
+                if (value) |v| hash(hasher, v);
+            },
+            .pointer => {
+                hasher.update(std.mem.asBytes(&value.len));
+                for (value) |item| hash(hasher, item);
+            },
+            .@"struct" => |info| inline for (info.fields) |field| {
+                if (!comptime isAllocator(field.name)) {
+                    hash(hasher, @field(value, field.name));
+                }
+            },
+            else => hasher.update(std.mem.asBytes(&value)),
+        }
+    }
+
+    fn isAllocator(comptime name: []const u8) bool {
+        return std.mem.eql(u8, name, "__allocator");
+    }
+};
+
+// Prints the fields of generated structs in format methods.
+const debug_format = struct {
+    fn any(writer: anytype, value: anytype) !void {
+        try writer.print("{any}", .{value});
+    }
+
+    fn string(writer: anytype, value: []const u8) !void {
+        try writer.print("\"{}\"", .{std.zig.fmtEscapes(value)});
+    }
+
+    fn bytes(writer: anytype, value: []const u8) !void {
+        try writer.print("0x{}", .{std.fmt.fmtSliceHexLower(value)});
+    }
+
+    fn uuid(writer: anytype, value: [16]u8) !void {
+        try writer.print("{}-{}-{}-{}-{}", .{
+            std.fmt.fmtSliceHexLower(value[0..4]),
+            std.fmt.fmtSliceHexLower(value[4..6]),
+            std.fmt.fmtSliceHexLower(value[6..8]),
+            std.fmt.fmtSliceHexLower(value[8..10]),
+            std.fmt.fmtSliceHexLower(value[10..16]),
+        });
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC, e.g.
+    // 2024-01-02T03:04:05.000006Z. Timestamps before the epoch are printed as
+    // numbers.
+    fn timestamp(writer: anytype, value: i64) !void {
+        if (value < 0) {
+            return writer.print("{d}", .{value});
+        }
+        try printTimestamp(writer, @intCast(value));
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn @"enum"(writer: anytype, value: anytype) !void {
+        try writer.print(".{s}", .{@tagName(value)});
+    }
+
+    fn cidr(writer: anytype, value: pg.Cidr) !void {
+        if (value.address.len == 4) {
+            try writer.print("{d}.{d}.{d}.{d}", .{ value.address[0], value.address[1], value.address[2], value.address[3] });
+        } else {
+            var i: usize = 0;
+            while (i + 1 < value.address.len) : (i += 2) {
+                if (i > 0) {
+                    try writer.writeByte(':');
+                }
+                try writer.print("{x}", .{@as(u16, value.address[i]) << 8 | value.address[i + 1]});
+            }
+        }
+        try writer.print("/{d}", .{value.netmask});
+    }
+
+    fn numeric(writer: anytype, value: pg.Numeric) !void {
+        try writer.print("{d}", .{value.toFloat()});
+    }
+
+    fn array(writer: anytype, values: anytype, comptime formatValue: anytype) !void {
+        try writer.writeAll("{ ");
+        for (values, 0..) |value, i| {
+            if (i > 0) {
+                try writer.writeAll(", ");
+            }
+            try formatValue(writer, value);
+        }
+        try writer.writeAll(" }");
+    }
+};
+
+// The statement_timeout of a connection before a query with a timeout replaced
+// it. Inside a transaction block the timeout is only set for the transaction,
+// so that a transaction aborted by the query reverts it when rolled back.
+const StatementTimeout = struct {
+    buf: [32]u8 = undefined,
+    len: usize = 0,
+    local: bool = false,
+
+    // Sets the statement_timeout of conn and returns the previous value, in a
+    // single round trip. Outside a transaction block, the statement and
+    // transaction timestamps are the same.
+    fn set(conn: *pg.Conn, timeout: []const u8) !StatementTimeout {
+        const result = try conn.query(
+            \\SELECT current_setting('statement_timeout'),
+            \\  set_config('statement_timeout', $1, statement_timestamp() <> transaction_timestamp()),
+            \\  statement_timestamp() <> transaction_timestamp()
+        , .{timeout});
+        defer result.deinit();
+        const row = (try result.next()) orelse unreachable;
+        const current = row.get([]const u8, 0);
+        var previous = StatementTimeout{ .local = row.get(bool, 2) };
+        if (current.len > previous.buf.len) {
+            return error.InvalidStatementTimeout;
+        }
+        @memcpy(previous.buf[0..current.len], current);
+        previous.len = current.len;
+        return previous;
+    }
+
+    // Restores the statement_timeout of conn saved by set. A transaction
+    // aborted by the query cannot run it, rolling back restores the timeout
+    // instead.
+    fn restore(self: *const StatementTimeout, conn: *pg.Conn) !void {
+        const result = conn.query("SELECT set_config('statement_timeout', $1, $2)", .{ self.buf[0..self.len], self.local }) catch |err| {
+            if (self.local and err == error.PG) {
+                const pge = conn.err orelse return err;
+                // in_failed_sql_transaction
+                if (std.mem.eql(u8, pge.code, "25P02")) {
+                    return;
+                }
+            }
+            return err;
+        };
+        defer result.deinit();
+    }
+};
+
+// Reports whether err was caused by a query exceeding its statement_timeout.
+fn isTimeout(conn: *pg.Conn, err: anyerror) bool {
+    if (err != error.PG) {
+        return false;
+    }
+    const pge = conn.err orelse return false;
+    // query_canceled
+    return std.mem.eql(u8, pge.code, "57014");
+}
+
+// Returns error.Timeout in place of err if it was caused by a query exceeding
+// its statement_timeout.
+fn timeoutError(conn: *pg.Conn, err: anytype) @TypeOf(err) || error{Timeout} {
+    return if (isTimeout(conn, err)) error.Timeout else err;
+}
+
+// Hooks for a Querier that does not observe its queries.
+pub const NoHooks = struct {};
+
+// Calls the optional callbacks declared by Hooks around a query:
+//
+//   pub fn beforeQuery(name: []const u8, sql: []const u8) void
+//   pub fn afterQuery(name: []const u8, duration_ns: u64, rows: ?usize, err: ?anyerror) void
+//
+// The name is the name of the query method. Rows is the number of rows
+// returned, or null for methods passing rows to a context. Callbacks that are
+// not declared are not called, and their overhead compiles away.
+fn QueryHook(comptime Hooks: type) type {
+    return struct {
+        name: []const u8,
+        start: if (@hasDecl(Hooks, "afterQuery")) i128 else void,
+
+        fn begin(name: []const u8, sql: []const u8) @This() {
+            if (@hasDecl(Hooks, "beforeQuery")) {
+                Hooks.beforeQuery(name, sql);
+            }
+            return .{
+                .name = name,
+                .start = if (@hasDecl(Hooks, "afterQuery")) std.time.nanoTimestamp() else {},
+            };
+        }
+
+        fn end(self: @This(), rows: ?usize, err: ?anyerror) void {
+            if (@hasDecl(Hooks, "afterQuery")) {
+                const duration: u64 = @intCast(@max(0, std.time.nanoTimestamp() - self.start));
+                Hooks.afterQuery(self.name, duration, rows, err);
+            }
+        }
+    };
+}
+
+pub const ConnQuerierInterface = QuerierInterface(*pg.Conn, NoHooks);
+pub const PoolQuerierInterface = QuerierInterface(*pg.Pool, NoHooks);
+
+// A type erased interface over the methods of Querier(T). It can be backed by
+// a Querier or any other type declaring the same methods, such as a mock.
+// Methods taking a context are not part of the interface.
+pub fn QuerierInterface(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        ptr: *anyopaque,
+        vtable: *const VTable,
+
+        pub const VTable = struct {
+            createOrder: *const fn (ptr: *anyopaque, create_order_params: Querier(T, Hooks).CreateOrderParams) anyerror!Querier(T, Hooks).CreateOrderResult,
+            getOrderByID: *const fn (ptr: *anyopaque, id: i32) anyerror!Querier(T, Hooks).GetOrderByIDResult,
+            getOrderPartial: *const fn (ptr: *anyopaque) anyerror!Querier(T, Hooks).GetOrderPartialResult,
+            getOrderTotals: *const fn (ptr: *anyopaque) anyerror!Querier(T, Hooks).GetOrderTotalsResult,
+            getOrders: *const fn (ptr: *anyopaque) anyerror!Querier(T, Hooks).GetOrdersResult,
+        };
+
+        // Returns an interface calling the methods of impl.
+        pub fn init(comptime Impl: type, impl: *Impl) Self {
+            return .{ .ptr = impl, .vtable = &VTableFor(Impl).vtable };
+        }
+
+        fn VTableFor(comptime Impl: type) type {
+            return struct {
+                const vtable = VTable{
+                    .createOrder = struct {
+                        fn call(ptr: *anyopaque, create_order_params: Querier(T, Hooks).CreateOrderParams) anyerror!Querier(T, Hooks).CreateOrderResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.createOrder(create_order_params);
+                        }
+                    }.call,
+                    .getOrderByID = struct {
+                        fn call(ptr: *anyopaque, id: i32) anyerror!Querier(T, Hooks).GetOrderByIDResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getOrderByID(id);
+                        }
+                    }.call,
+                    .getOrderPartial = struct {
+                        fn call(ptr: *anyopaque) anyerror!Querier(T, Hooks).GetOrderPartialResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getOrderPartial();
+                        }
+                    }.call,
+                    .getOrderTotals = struct {
+                        fn call(ptr: *anyopaque) anyerror!Querier(T, Hooks).GetOrderTotalsResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getOrderTotals();
+                        }
+                    }.call,
+                    .getOrders = struct {
+                        fn call(ptr: *anyopaque) anyerror!Querier(T, Hooks).GetOrdersResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getOrders();
+                        }
+                    }.call,
+                };
+            };
+        }
+
+        pub fn createOrder(self: Self, create_order_params: Querier(T, Hooks).CreateOrderParams) anyerror!Querier(T, Hooks).CreateOrderResult {
+            return self.vtable.createOrder(self.ptr, create_order_params);
+        }
+
+        pub fn getOrderByID(self: Self, id: i32) anyerror!Querier(T, Hooks).GetOrderByIDResult {
+            return self.vtable.getOrderByID(self.ptr, id);
+        }
+
+        pub fn getOrderPartial(self: Self) anyerror!Querier(T, Hooks).GetOrderPartialResult {
+            return self.vtable.getOrderPartial(self.ptr);
+        }
+
+        pub fn getOrderTotals(self: Self) anyerror!Querier(T, Hooks).GetOrderTotalsResult {
+            return self.vtable.getOrderTotals(self.ptr);
+        }
+
+        pub fn getOrders(self: Self) anyerror!Querier(T, Hooks).GetOrdersResult {
+            return self.vtable.getOrders(self.ptr);
+        }
+    };
+}
+
+pub const ConnMockQuerier = MockQuerier(*pg.Conn, NoHooks);
+pub const PoolMockQuerier = MockQuerier(*pg.Pool, NoHooks);
+
+// Records the calls to a single MockQuerier method and the result to return.
+pub fn MockMethod(comptime Args: type, comptime Result: type) type {
+    return struct {
+        // The value returned by the method. Methods returning void succeed
+        // without a result.
+        result: ?Result = null,
+        // An error returned by the method instead of the result.
+        err: ?anyerror = null,
+        // Computes the result of the method from its arguments. Takes
+        // precedence over result and err when set.
+        handler: ?*const fn (args: Args) anyerror!Result = null,
+        // The number of calls expected by MockQuerier.verify, or null to
+        // accept any number of calls.
+        expected_calls: ?usize = null,
+        // The number of times the method was called.
+        calls: usize = 0,
+        // The arguments of the most recent call.
+        last_args: ?Args = null,
+
+        fn call(self: *@This(), args: Args) anyerror!Result {
+            self.calls += 1;
+            self.last_args = args;
+            if (self.handler) |handler| {
+                return handler(args);
+            }
+            if (self.err) |err| {
+                return err;
+            }
+            if (Result == void) {
+                return;
+            }
+            return self.result orelse error.UnexpectedCall;
+        }
+    };
+}
+
+// A mock implementation of the methods of Querier(T) for unit tests. Canned
+// results and expectations are set per method on the expect field, calls
+// without a result set return error.UnexpectedCall unless the method returns
+// void.
+pub fn MockQuerier(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        expect: struct {
+            createOrder: MockMethod(struct { create_order_params: Querier(T, Hooks).CreateOrderParams }, Querier(T, Hooks).CreateOrderResult) = .{},
+            getOrderByID: MockMethod(struct { id: i32 }, Querier(T, Hooks).GetOrderByIDResult) = .{},
+            getOrderPartial: MockMethod(struct {}, Querier(T, Hooks).GetOrderPartialResult) = .{},
+            getOrderTotals: MockMethod(struct {}, Querier(T, Hooks).GetOrderTotalsResult) = .{},
+            getOrders: MockMethod(struct {}, Querier(T, Hooks).GetOrdersResult) = .{},
+        } = .{},
+
+        pub fn interface(self: *Self) QuerierInterface(T, Hooks) {
+            return QuerierInterface(T, Hooks).init(Self, self);
+        }
+
+        // Returns error.UnexpectedCallCount if any method with expected_calls
+        // set was called a different number of times.
+        pub fn verify(self: Self) !void {
+            inline for (std.meta.fields(@TypeOf(self.expect))) |field| {
+                const method = @field(self.expect, field.name);
+                if (method.expected_calls) |expected| {
+                    if (method.calls != expected) {
+                        return error.UnexpectedCallCount;
+                    }
+                }
+            }
+        }
+
+        pub fn createOrder(self: *Self, create_order_params: Querier(T, Hooks).CreateOrderParams) anyerror!Querier(T, Hooks).CreateOrderResult {
+            return self.expect.createOrder.call(.{ .create_order_params = create_order_params });
+        }
+
+        pub fn getOrderByID(self: *Self, id: i32) anyerror!Querier(T, Hooks).GetOrderByIDResult {
+            return self.expect.getOrderByID.call(.{ .id = id });
+        }
+
+        pub fn getOrderPartial(self: *Self) anyerror!Querier(T, Hooks).GetOrderPartialResult {
+            return self.expect.getOrderPartial.call(.{});
+        }
+
+        pub fn getOrderTotals(self: *Self) anyerror!Querier(T, Hooks).GetOrderTotalsResult {
+            return self.expect.getOrderTotals.call(.{});
+        }
+
+        pub fn getOrders(self: *Self) anyerror!Querier(T, Hooks).GetOrdersResult {
+            return self.expect.getOrders.call(.{});
+        }
+    };
+}
@@ -0,0 +1,170 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+
+pub const models = @import("models.zig");
+pub const ApiKey = models.ApiKey;
+pub const Order = models.Order;
+pub const Product = models.Product;
+pub const User = models.User;
+pub const UserRole = models.UserRole;
+pub const billing = models.billing;
+
+pub const annotated = @import("annotated.sql.zig");
+pub const api_keys = @import("api_keys.sql.zig");
+pub const invoices = @import("invoices.sql.zig");
+pub const orders = @import("orders.sql.zig");
+pub const users = @import("users.sql.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn, NoHooks);
+pub const PoolQuerier = Querier(*pg.Pool, NoHooks);
+
+pub const NoHooks = struct {};
+
+// Runs the queries of all queries files, holding the Querier of each file in a
+// field named after it.
+pub fn Querier(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        annotated: annotated.Querier(T, Hooks),
+        api_keys: api_keys.Querier(T, Hooks),
+        invoices: invoices.Querier(T, Hooks),
+        orders: orders.Querier(T, Hooks),
+        users: users.Querier(T, Hooks),
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{
+                .annotated = annotated.Querier(T, Hooks).init(allocator, conn),
+                .api_keys = api_keys.Querier(T, Hooks).init(allocator, conn),
+                .invoices = invoices.Querier(T, Hooks).init(allocator, conn),
+                .orders = orders.Querier(T, Hooks).init(allocator, conn),
+                .users = users.Querier(T, Hooks).init(allocator, conn),
+            };
+        }
+
+        pub fn archiveUser(self: Self, archive_user_params: annotated.Querier(T, Hooks).ArchiveUserParams) !annotated.Querier(T, Hooks).ArchiveUserResult {
+            return self.annotated.archiveUser(archive_user_params);
+        }
+
+        pub fn findUser(self: Self, email: []const u8) !annotated.Querier(T, Hooks).FindUserResult {
+            return self.annotated.findUser(email);
+        }
+
+        //  Streams the id and email of every user.
+        pub fn listUserEmails(self: Self, ctx: anytype) !void {
+            return self.annotated.listUserEmails(ctx);
+        }
+
+        pub fn getAPIKey(self: Self, id: [16]u8) !api_keys.Querier(T, Hooks).GetAPIKeyResult {
+            return self.api_keys.getAPIKey(id);
+        }
+
+        pub fn listAPIKeySecrets(self: Self, user_id: i32) !api_keys.Querier(T, Hooks).ListAPIKeySecretsResult {
+            return self.api_keys.listAPIKeySecrets(user_id);
+        }
+
+        pub fn freeListAPIKeySecrets(self: Self, rows: []const api_keys.Querier(T, Hooks).ListAPIKeySecretsRow) void {
+            self.api_keys.freeListAPIKeySecrets(rows);
+        }
+
+        pub fn createInvoice(self: Self, create_invoice_params: invoices.Querier(T, Hooks).CreateInvoiceParams) !invoices.Querier(T, Hooks).CreateInvoiceResult {
+            return self.invoices.createInvoice(create_invoice_params);
+        }
+
+        pub fn getInvoiceStatus(self: Self, id: i32) !invoices.Querier(T, Hooks).GetInvoiceStatusResult {
+            return self.invoices.getInvoiceStatus(id);
+        }
+
+        pub fn getInvoices(self: Self) !invoices.Querier(T, Hooks).GetInvoicesResult {
+            return self.invoices.getInvoices();
+        }
+
+        pub fn freeGetInvoices(self: Self, rows: []const models.billing.Invoice) void {
+            self.invoices.freeGetInvoices(rows);
+        }
+
+        pub fn createOrder(self: Self, create_order_params: orders.Querier(T, Hooks).CreateOrderParams) !orders.Querier(T, Hooks).CreateOrderResult {
+            return self.orders.createOrder(create_order_params);
+        }
+
+        pub fn getOrderByID(self: Self, id: i32) !orders.Querier(T, Hooks).GetOrderByIDResult {
+            return self.orders.getOrderByID(id);
+        }
+
+        pub fn getOrderPartial(self: Self) !orders.Querier(T, Hooks).GetOrderPartialResult {
+            return self.orders.getOrderPartial();
+        }
+
+        pub fn freeGetOrderPartial(self: Self, rows: []const orders.Querier(T, Hooks).GetOrderPartialRow) void {
+            self.orders.freeGetOrderPartial(rows);
+        }
+
+        pub fn getOrderTotals(self: Self) !orders.Querier(T, Hooks).GetOrderTotalsResult {
+            return self.orders.getOrderTotals();
+        }
+
+        pub fn freeGetOrderTotals(self: Self, rows: []const pg.Numeric) void {
+            self.orders.freeGetOrderTotals(rows);
+        }
+
+        pub fn getOrders(self: Self) !orders.Querier(T, Hooks).GetOrdersResult {
+            return self.orders.getOrders();
+        }
+
+        pub fn freeGetOrders(self: Self, rows: []const models.Order) void {
+            self.orders.freeGetOrders(rows);
+        }
+
+        pub fn createUser(self: Self, create_user_params: users.Querier(T, Hooks).CreateUserParams) !users.Querier(T, Hooks).CreateUserResult {
+            return self.users.createUser(create_user_params);
+        }
+
+        pub fn getUser(self: Self, id: i32) !users.Querier(T, Hooks).GetUserResult {
+            return self.users.getUser(id);
+        }
+
+        pub fn getUserEmails(self: Self) !users.Querier(T, Hooks).GetUserEmailsResult {
+            return self.users.getUserEmails();
+        }
+
+        pub fn freeGetUserEmails(self: Self, rows: []const users.Querier(T, Hooks).GetUserEmailsRow) void {
+            self.users.freeGetUserEmails(rows);
+        }
+
+        pub fn getUserIDByEmail(self: Self, email: []const u8) !users.Querier(T, Hooks).GetUserIDByEmailResult {
+            return self.users.getUserIDByEmail(email);
+        }
+
+        pub fn getUserIDsByIPAddress(self: Self, ip_address: []const u8) !users.Querier(T, Hooks).GetUserIDsByIPAddressResult {
+            return self.users.getUserIDsByIPAddress(ip_address);
+        }
+
+        pub fn getUserIDsByRole(self: Self, role: models.UserRole) !users.Querier(T, Hooks).GetUserIDsByRoleResult {
+            return self.users.getUserIDsByRole(role);
+        }
+
+        pub fn getUserIDsBySalaryRange(self: Self, salary_1: f64, salary_2: f64) !users.Querier(T, Hooks).GetUserIDsBySalaryRangeResult {
+            return self.users.getUserIDsBySalaryRange(salary_1, salary_2);
+        }
+
+        pub fn getUserNames(self: Self) !users.Querier(T, Hooks).GetUserNamesResult {
+            return self.users.getUserNames();
+        }
+
+        pub fn freeGetUserNames(self: Self, rows: []const []const u8) void {
+            self.users.freeGetUserNames(rows);
+        }
+
+        pub fn getUsers(self: Self) !users.Querier(T, Hooks).GetUsersResult {
+            return self.users.getUsers();
+        }
+
+        pub fn freeGetUsers(self: Self, rows: []const models.User) void {
+            self.users.freeGetUsers(rows);
+        }
+    };
+}
@@ -0,0 +1,1810 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+const catalog = @import("queries.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn, NoHooks);
+pub const PoolQuerier = Querier(*pg.Pool, NoHooks);
+
+pub fn Querier(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(T, Hooks) {
+            return QuerierInterface(T, Hooks).init(Self, self);
+        }
+        
+        const create_user_sql = 
+            \\INSERT INTO users (
+            \\    name, 
+            \\    email, 
+            \\    password, 
+            \\    role, 
+            \\    ip_address,
+            \\    salary,
+            \\    created_at,
+            \\    updated_at
+            \\) VALUES (
+            \\    $1, $2, $3, $4, $5, $6, NOW(), NOW()
+            \\)
+        ;
+
+        pub const CreateUserParams = struct {
+            name: []const u8,
+            email: []const u8,
+            password: []const u8,
+            role: models.UserRole,
+            ip_address: ?[]const u8 = null,
+            salary: ?f64 = null,
+
+            pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+                _ = fmt;
+                _ = options;
+                try writer.writeAll("CreateUserParams{ .name = ");
+                try debug_format.string(writer, self.name);
+                try writer.writeAll(", .email = ");
+                try debug_format.string(writer, self.email);
+                try writer.writeAll(", .password = <redacted>");
+                try writer.writeAll(", .role = ");
+                try debug_format.@"enum"(writer, self.role);
+                try writer.writeAll(", .ip_address = ");
+                if (self.ip_address) |value| {
+                    try debug_format.string(writer, value);
+                } else {
+                    try writer.writeAll("null");
+                }
+                try writer.writeAll(", .salary = ");
+                if (self.salary) |value| {
+                    try debug_format.any(writer, value);
+                } else {
+                    try writer.writeAll("null");
+                }
+                try writer.writeAll(" }");
+            }
+        };
+
+        pub const CreateUserResult = union(enum) {
+            ok: void,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .ok => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn createUser(self: Self, create_user_params: CreateUserParams) !CreateUserResult {
+            const hook = QueryHook(Hooks).begin("createUser", create_user_sql);
+            const result = self.createUserUnhooked(create_user_params);
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(0, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn createUserUnhooked(self: Self, create_user_params: CreateUserParams) !CreateUserResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.createUserUntimed(conn, create_user_params);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .ok => {},
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn createUserUntimed(self: Self, conn: *pg.Conn, create_user_params: CreateUserParams) !CreateUserResult {
+            const allocator = self.allocator;
+            _ = conn.exec(create_user_sql, .{ 
+                create_user_params.name,
+                create_user_params.email,
+                create_user_params.password,
+                create_user_params.role,
+                create_user_params.ip_address,
+                create_user_params.salary,
+            }) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            return .{ .ok = undefined };
+        }
+
+        const get_user_sql = 
+            \\SELECT id, name, email, password, role, ip_address, salary, notes, created_at, updated_at, archived_at FROM users
+            \\WHERE id = $1 LIMIT 1
+        ;
+
+        pub const GetUserResult = union(enum) {
+            user: models.User,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .user => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getUser(self: Self, id: i32) !GetUserResult {
+            const hook = QueryHook(Hooks).begin("getUser", get_user_sql);
+            const result = self.getUserUnhooked(id);
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(1, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserUnhooked(self: Self, id: i32) !GetUserResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.getUserUntimed(conn, id);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .user => |rows| {
+                        rows.deinit();
+                    },
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn getUserUntimed(self: Self, conn: *pg.Conn, id: i32) !GetUserResult {
+            const allocator = self.allocator;
+            const result = conn.query(get_user_sql, .{ 
+                id,
+            }) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            const row = (result.next() catch |err| return timeoutError(conn, err)) orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+            const row_name = try allocator.dupe(u8, row.get([]const u8, 1));
+            errdefer allocator.free(row_name);
+            const row_email = try allocator.dupe(u8, row.get([]const u8, 2));
+            errdefer allocator.free(row_email);
+            const row_password = try allocator.dupe(u8, row.get([]const u8, 3));
+            errdefer allocator.free(row_password);
+            const row_role = row.get(models.UserRole, 4);
+            const ip_address_cidr = row.get(?pg.Cidr, 5);
+            const row_ip_address: ?pg.Cidr = blk: {
+                if (ip_address_cidr) |cidr| {
+                    break :blk pg.Cidr{
+                        .address = try allocator.dupe(u8, cidr.address),
+                        .netmask = cidr.netmask,
+                        .family = cidr.family,
+                    };
+                }
+                break :blk null;
+            };
+            errdefer if (row_ip_address) |cidr| {
+                allocator.free(cidr.address);
+            };
+            const salary_numeric = row.get(?pg.Numeric, 6);
+            const row_salary: ?pg.Numeric = blk: {
+                if (salary_numeric) |numeric| {
+                    break :blk pg.Numeric{
+                        .number_of_digits = numeric.number_of_digits,
+                        .weight = numeric.weight,
+                        .sign = numeric.sign,
+                        .scale = numeric.scale,
+                        .digits = try allocator.dupe(u8, numeric.digits),
+                    };
+                }
+                break :blk null;
+            };
+            errdefer if (row_salary) |numeric| {
+                allocator.free(numeric.digits);
+            };
+
+            const maybe_notes = row.get(?[]const u8, 7);
+            const row_notes: ?[]const u8 = blk: {
+                if (maybe_notes) |field| {
+                    break :blk try allocator.dupe(u8, field);
+                }
+                break :blk null;
+            };
+            errdefer if (row_notes) |field| {
+                allocator.free(field);
+            };
+            const row_created_at = row.get(i64, 8);
+            const row_updated_at = row.get(i64, 9);
+            const row_archived_at = row.get(?i64, 10);
+
+            return .{
+                .user = .{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .name = row_name,
+                    .email = row_email,
+                    .password = row_password,
+                    .role = row_role,
+                    .ip_address = row_ip_address,
+                    .salary = row_salary,
+                    .notes = row_notes,
+                    .created_at = row_created_at,
+                    .updated_at = row_updated_at,
+                    .archived_at = row_archived_at,
+                }
+            };
+        }
+
+        const get_user_emails_sql = 
+            \\SELECT id, email FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetUserEmailsRow = struct {
+            __allocator: Allocator,
+
+            id: i32,
+            email: []const u8,
+
+            pub fn deinit(self: *const GetUserEmailsRow) void {
+                self.__allocator.free(self.email);
+            }
+
+            pub fn jsonStringify(self: @This(), jw: anytype) !void {
+                try jw.beginObject();
+                try jw.objectField("id");
+                try jw.write(self.id);
+                try jw.objectField("email");
+                try jw.write(self.email);
+                try jw.endObject();
+            }
+
+            pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+                _ = fmt;
+                _ = options;
+                try writer.writeAll("GetUserEmailsRow{ .id = ");
+                try debug_format.any(writer, self.id);
+                try writer.writeAll(", .email = ");
+                try debug_format.string(writer, self.email);
+                try writer.writeAll(" }");
+            }
+
+            // Returns a deep copy of the struct owned by allocator, to be freed with
+            // deinit.
+            pub fn clone(self: @This(), allocator: Allocator) !@This() {
+                return deep.clone(allocator, self);
+            }
+
+            // Reports whether all fields of the structs hold equal values.
+            pub fn eql(self: @This(), other: @This()) bool {
+                return deep.eql(self, other);
+            }
+
+            // Hashes the values of all fields, consistent with eql.
+            pub fn hash(self: @This()) u64 {
+                var hasher = std.hash.Wyhash.init(0);
+                deep.hash(&hasher, self);
+                return hasher.final();
+            }
+        };
+
+        pub const GetUserEmailsResult = union(enum) {
+            get_user_emails_row_list: []GetUserEmailsRow,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .get_user_emails_row_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getUserEmails(self: Self) !GetUserEmailsResult {
+            const hook = QueryHook(Hooks).begin("getUserEmails", get_user_emails_sql);
+            const result = self.getUserEmailsUnhooked();
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(value.get_user_emails_row_list.len, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserEmailsUnhooked(self: Self) !GetUserEmailsResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.getUserEmailsUntimed(conn);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .get_user_emails_row_list => |rows| {
+                        self.freeGetUserEmails(rows);
+                    },
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn getUserEmailsUntimed(self: Self, conn: *pg.Conn) !GetUserEmailsResult {
+            const allocator = self.allocator;
+            const result = conn.query(get_user_emails_sql, .{}) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList(GetUserEmailsRow).init(allocator);
+            defer out.deinit();
+            while (result.next() catch |err| return timeoutError(conn, err)) |row| {
+                const row_id = row.get(i32, 0);
+                const row_email = try allocator.dupe(u8, row.get([]const u8, 1));
+                errdefer allocator.free(row_email);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .email = row_email,
+                });
+            }
+
+            return .{
+                .get_user_emails_row_list = try out.toOwnedSlice(),
+            };
+        }
+
+        // Frees the rows returned by getUserEmails along with the slice
+        // holding them.
+        pub fn freeGetUserEmails(self: Self, rows: []const GetUserEmailsRow) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+        const get_user_id_by_email_sql = 
+            \\SELECT id FROM users
+            \\WHERE email = $1 LIMIT 1
+        ;
+
+        pub const GetUserIDByEmailResult = union(enum) {
+            id: i32,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .id => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getUserIDByEmail(self: Self, email: []const u8) !GetUserIDByEmailResult {
+            const hook = QueryHook(Hooks).begin("getUserIDByEmail", get_user_id_by_email_sql);
+            const result = self.getUserIDByEmailUnhooked(email);
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(1, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserIDByEmailUnhooked(self: Self, email: []const u8) !GetUserIDByEmailResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.getUserIDByEmailUntimed(conn, email);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .id => {},
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn getUserIDByEmailUntimed(self: Self, conn: *pg.Conn, email: []const u8) !GetUserIDByEmailResult {
+            const allocator = self.allocator;
+            const result = conn.query(get_user_id_by_email_sql, .{ 
+                email,
+            }) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            const row = (result.next() catch |err| return timeoutError(conn, err)) orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+
+            return .{ .id = row_id};
+        }
+
+        const get_user_i_ds_by_ip_address_sql = 
+            \\SELECT id FROM users
+            \\WHERE ip_address = $1
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetUserIDsByIPAddressResult = union(enum) {
+            id_list: []i32,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .id_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getUserIDsByIPAddress(self: Self, ip_address: []const u8) !GetUserIDsByIPAddressResult {
+            const hook = QueryHook(Hooks).begin("getUserIDsByIPAddress", get_user_i_ds_by_ip_address_sql);
+            const result = self.getUserIDsByIPAddressUnhooked(ip_address);
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(value.id_list.len, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserIDsByIPAddressUnhooked(self: Self, ip_address: []const u8) !GetUserIDsByIPAddressResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.getUserIDsByIPAddressUntimed(conn, ip_address);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .id_list => |rows| {
+                        self.allocator.free(rows);
+                    },
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn getUserIDsByIPAddressUntimed(self: Self, conn: *pg.Conn, ip_address: []const u8) !GetUserIDsByIPAddressResult {
+            const allocator = self.allocator;
+            const result = conn.query(get_user_i_ds_by_ip_address_sql, .{ 
+                ip_address,
+            }) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList(i32).init(allocator);
+            defer out.deinit();
+            while (result.next() catch |err| return timeoutError(conn, err)) |row| {
+                const row_id = row.get(i32, 0);
+                try out.append(row_id);
+            }
+
+            return .{
+                .id_list = try out.toOwnedSlice(),
+            };
+        }
+
+        const get_user_i_ds_by_role_sql = 
+            \\SELECT id FROM users
+            \\WHERE role = $1
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetUserIDsByRoleResult = union(enum) {
+            id_list: []i32,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .id_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getUserIDsByRole(self: Self, role: models.UserRole) !GetUserIDsByRoleResult {
+            const hook = QueryHook(Hooks).begin("getUserIDsByRole", get_user_i_ds_by_role_sql);
+            const result = self.getUserIDsByRoleUnhooked(role);
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(value.id_list.len, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserIDsByRoleUnhooked(self: Self, role: models.UserRole) !GetUserIDsByRoleResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.getUserIDsByRoleUntimed(conn, role);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .id_list => |rows| {
+                        self.allocator.free(rows);
+                    },
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn getUserIDsByRoleUntimed(self: Self, conn: *pg.Conn, role: models.UserRole) !GetUserIDsByRoleResult {
+            const allocator = self.allocator;
+            const result = conn.query(get_user_i_ds_by_role_sql, .{ 
+                role,
+            }) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList(i32).init(allocator);
+            defer out.deinit();
+            while (result.next() catch |err| return timeoutError(conn, err)) |row| {
+                const row_id = row.get(i32, 0);
+                try out.append(row_id);
+            }
+
+            return .{
+                .id_list = try out.toOwnedSlice(),
+            };
+        }
+
+        const get_user_i_ds_by_salary_range_sql = 
+            \\SELECT id FROM users
+            \\WHERE salary >= $1 AND salary <= $2
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetUserIDsBySalaryRangeResult = union(enum) {
+            id_list: []i32,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .id_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getUserIDsBySalaryRange(self: Self, salary_1: f64, salary_2: f64) !GetUserIDsBySalaryRangeResult {
+            const hook = QueryHook(Hooks).begin("getUserIDsBySalaryRange", get_user_i_ds_by_salary_range_sql);
+            const result = self.getUserIDsBySalaryRangeUnhooked(salary_1, salary_2);
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(value.id_list.len, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserIDsBySalaryRangeUnhooked(self: Self, salary_1: f64, salary_2: f64) !GetUserIDsBySalaryRangeResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.getUserIDsBySalaryRangeUntimed(conn, salary_1, salary_2);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .id_list => |rows| {
+                        self.allocator.free(rows);
+                    },
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn getUserIDsBySalaryRangeUntimed(self: Self, conn: *pg.Conn, salary_1: f64, salary_2: f64) !GetUserIDsBySalaryRangeResult {
+            const allocator = self.allocator;
+            const result = conn.query(get_user_i_ds_by_salary_range_sql, .{ 
+                salary_1,
+                salary_2,
+            }) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList(i32).init(allocator);
+            defer out.deinit();
+            while (result.next() catch |err| return timeoutError(conn, err)) |row| {
+                const row_id = row.get(i32, 0);
+                try out.append(row_id);
+            }
+
+            return .{
+                .id_list = try out.toOwnedSlice(),
+            };
+        }
+
+        const get_user_names_sql = 
+            \\SELECT name FROM users
+        ;
+
+        pub const GetUserNamesResult = union(enum) {
+            name_list: [][]const u8,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .name_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getUserNames(self: Self) !GetUserNamesResult {
+            const hook = QueryHook(Hooks).begin("getUserNames", get_user_names_sql);
+            const result = self.getUserNamesUnhooked();
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(value.name_list.len, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserNamesUnhooked(self: Self) !GetUserNamesResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.getUserNamesUntimed(conn);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .name_list => |rows| {
+                        self.freeGetUserNames(rows);
+                    },
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn getUserNamesUntimed(self: Self, conn: *pg.Conn) !GetUserNamesResult {
+            const allocator = self.allocator;
+            const result = conn.query(get_user_names_sql, .{}) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList([]const u8).init(allocator);
+            defer out.deinit();
+            while (result.next() catch |err| return timeoutError(conn, err)) |row| {
+                const row_name = try allocator.dupe(u8, row.get([]const u8, 0));
+                errdefer allocator.free(row_name);
+                try out.append(row_name);
+            }
+
+            return .{
+                .name_list = try out.toOwnedSlice(),
+            };
+        }
+
+        // Frees the rows returned by getUserNames along with the slice
+        // holding them.
+        pub fn freeGetUserNames(self: Self, rows: []const []const u8) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                allocator.free(row);
+            }
+            allocator.free(rows);
+        }
+
+        const get_users_sql = 
+            \\SELECT id, name, email, password, role, ip_address, salary, notes, created_at, updated_at, archived_at FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetUsersResult = union(enum) {
+            user_list: []models.User,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .user_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getUsers(self: Self) !GetUsersResult {
+            const hook = QueryHook(Hooks).begin("getUsers", get_users_sql);
+            const result = self.getUsersUnhooked();
+            if (result) |value| {
+                if (value == .pgerr) {
+                    hook.end(0, error.PG);
+                } else {
+                    hook.end(value.user_list.len, null);
+                }
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUsersUnhooked(self: Self) !GetUsersResult {
+            const conn: *pg.Conn = if (T == *pg.Pool) try self.conn.acquire() else self.conn;
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const timeout = try StatementTimeout.set(conn, "500");
+            const result = self.getUsersUntimed(conn);
+            if (result) |value| {
+                errdefer switch (value) {
+                    .user_list => |rows| {
+                        self.freeGetUsers(rows);
+                    },
+                    .pgerr => |data| self.allocator.free(data),
+                };
+                try timeout.restore(conn);
+                return value;
+            } else |err| {
+                try timeout.restore(conn);
+                return err;
+            }
+        }
+
+        fn getUsersUntimed(self: Self, conn: *pg.Conn) !GetUsersResult {
+            const allocator = self.allocator;
+            const result = conn.query(get_users_sql, .{}) catch |err| {
+                if (isTimeout(conn, err)) {
+                    return error.Timeout;
+                }
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList(models.User).init(allocator);
+            defer out.deinit();
+            while (result.next() catch |err| return timeoutError(conn, err)) |row| {
+                const row_id = row.get(i32, 0);
+                const row_name = try allocator.dupe(u8, row.get([]const u8, 1));
+                errdefer allocator.free(row_name);
+                const row_email = try allocator.dupe(u8, row.get([]const u8, 2));
+                errdefer allocator.free(row_email);
+                const row_password = try allocator.dupe(u8, row.get([]const u8, 3));
+                errdefer allocator.free(row_password);
+                const row_role = row.get(models.UserRole, 4);
+                const ip_address_cidr = row.get(?pg.Cidr, 5);
+                const row_ip_address: ?pg.Cidr = blk: {
+                    if (ip_address_cidr) |cidr| {
+                        break :blk pg.Cidr{
+                            .address = try allocator.dupe(u8, cidr.address),
+                            .netmask = cidr.netmask,
+                            .family = cidr.family,
+                        };
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_ip_address) |cidr| {
+                    allocator.free(cidr.address);
+                };
+                const salary_numeric = row.get(?pg.Numeric, 6);
+                const row_salary: ?pg.Numeric = blk: {
+                    if (salary_numeric) |numeric| {
+                        break :blk pg.Numeric{
+                            .number_of_digits = numeric.number_of_digits,
+                            .weight = numeric.weight,
+                            .sign = numeric.sign,
+                            .scale = numeric.scale,
+                            .digits = try allocator.dupe(u8, numeric.digits),
+                        };
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_salary) |numeric| {
+                    allocator.free(numeric.digits);
+                };
+
+                const maybe_notes = row.get(?[]const u8, 7);
+                const row_notes: ?[]const u8 = blk: {
+                    if (maybe_notes) |field| {
+                        break :blk try allocator.dupe(u8, field);
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_notes) |field| {
+                    allocator.free(field);
+                };
+                const row_created_at = row.get(i64, 8);
+                const row_updated_at = row.get(i64, 9);
+                const row_archived_at = row.get(?i64, 10);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .name = row_name,
+                    .email = row_email,
+                    .password = row_password,
+                    .role = row_role,
+                    .ip_address = row_ip_address,
+                    .salary = row_salary,
+                    .notes = row_notes,
+                    .created_at = row_created_at,
+                    .updated_at = row_updated_at,
+                    .archived_at = row_archived_at,
+                });
+            }
+
+            return .{
+                .user_list = try out.toOwnedSlice(),
+            };
+        }
+
+        // Frees the rows returned by getUsers along with the slice
+        // holding them.
+        pub fn freeGetUsers(self: Self, rows: []const models.User) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
+
+// Routes queries between a primary and a replica pool. Queries returning rows
+// from a SELECT statement run on the replica, all other queries run on the
+// primary. Queries in a transaction always run on the primary.
+pub fn RoutedQuerier(comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+        const Pool = Querier(*pg.Pool, Hooks);
+        const Conn = Querier(*pg.Conn, Hooks);
+
+        primary: Pool,
+        replica: Pool,
+
+        pub fn init(allocator: Allocator, primary: *pg.Pool, replica: *pg.Pool) Self {
+            return .{
+                .primary = Pool.init(allocator, primary),
+                .replica = Pool.init(allocator, replica),
+            };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(*pg.Pool, Hooks) {
+            return QuerierInterface(*pg.Pool, Hooks).init(Self, self);
+        }
+
+        // Begins a transaction on a connection acquired from the primary pool. The
+        // connection is released by commit or rollback.
+        pub fn begin(self: Self) !Transaction {
+            const conn = try self.primary.conn.acquire();
+            errdefer self.primary.conn.release(conn);
+            try conn.begin();
+            return .{
+                .querier = Conn.init(self.primary.allocator, conn),
+                .pool = self.primary.conn,
+            };
+        }
+
+        pub const Transaction = struct {
+            querier: Conn,
+            pool: *pg.Pool,
+
+            pub fn commit(self: Transaction) !void {
+                defer self.pool.release(self.querier.conn);
+                try self.querier.conn.commit();
+            }
+
+            pub fn rollback(self: Transaction) !void {
+                defer self.pool.release(self.querier.conn);
+                try self.querier.conn.rollback();
+            }
+        };
+
+        pub fn createUser(self: Self, create_user_params: Pool.CreateUserParams) !Pool.CreateUserResult {
+            return self.primary.createUser(create_user_params);
+        }
+
+        pub fn getUser(self: Self, id: i32) !Pool.GetUserResult {
+            return self.replica.getUser(id);
+        }
+
+        pub fn getUserEmails(self: Self) !Pool.GetUserEmailsResult {
+            return self.replica.getUserEmails();
+        }
+
+        pub fn freeGetUserEmails(self: Self, rows: []const Pool.GetUserEmailsRow) void {
+            self.primary.freeGetUserEmails(rows);
+        }
+
+        pub fn getUserIDByEmail(self: Self, email: []const u8) !Pool.GetUserIDByEmailResult {
+            return self.replica.getUserIDByEmail(email);
+        }
+
+        pub fn getUserIDsByIPAddress(self: Self, ip_address: []const u8) !Pool.GetUserIDsByIPAddressResult {
+            return self.replica.getUserIDsByIPAddress(ip_address);
+        }
+
+        pub fn getUserIDsByRole(self: Self, role: models.UserRole) !Pool.GetUserIDsByRoleResult {
+            return self.replica.getUserIDsByRole(role);
+        }
+
+        pub fn getUserIDsBySalaryRange(self: Self, salary_1: f64, salary_2: f64) !Pool.GetUserIDsBySalaryRangeResult {
+            return self.replica.getUserIDsBySalaryRange(salary_1, salary_2);
+        }
+
+        pub fn getUserNames(self: Self) !Pool.GetUserNamesResult {
+            return self.replica.getUserNames();
+        }
+
+        pub fn freeGetUserNames(self: Self, rows: []const []const u8) void {
+            self.primary.freeGetUserNames(rows);
+        }
+
+        pub fn getUsers(self: Self) !Pool.GetUsersResult {
+            return self.replica.getUsers();
+        }
+
+        pub fn freeGetUsers(self: Self, rows: []const models.User) void {
+            self.primary.freeGetUsers(rows);
+        }
+    };
+}
+
+// The queries of this file, ordered by method name.
+pub const queries = [_]catalog.QueryInfo{
+    .{
+        .name = "createUser",
+        .sqlc_name = "CreateUser",
+        .file = "users.sql",
+        .cmd = ":exec",
+        .sql = ConnQuerier.create_user_sql,
+        .params = &.{
+            .{ .name = "name", .sql_type = "text", .nullable = false, .array = false },
+            .{ .name = "email", .sql_type = "text", .nullable = false, .array = false },
+            .{ .name = "password", .sql_type = "text", .nullable = false, .array = false },
+            .{ .name = "role", .sql_type = "user_role", .nullable = false, .array = false },
+            .{ .name = "ip_address", .sql_type = "inet", .nullable = true, .array = false },
+            .{ .name = "salary", .sql_type = "numeric", .nullable = true, .array = false },
+        },
+        .columns = &.{},
+    },
+    .{
+        .name = "getUser",
+        .sqlc_name = "GetUser",
+        .file = "users.sql",
+        .cmd = ":one",
+        .sql = ConnQuerier.get_user_sql,
+        .params = &.{
+            .{ .name = "id", .sql_type = "serial", .nullable = false, .array = false },
+        },
+        .columns = &.{ "id", "name", "email", "password", "role", "ip_address", "salary", "notes", "created_at", "updated_at", "archived_at" },
+    },
+    .{
+        .name = "getUserEmails",
+        .sqlc_name = "GetUserEmails",
+        .file = "users.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_user_emails_sql,
+        .params = &.{},
+        .columns = &.{ "id", "email" },
+    },
+    .{
+        .name = "getUserIDByEmail",
+        .sqlc_name = "GetUserIDByEmail",
+        .file = "users.sql",
+        .cmd = ":one",
+        .sql = ConnQuerier.get_user_id_by_email_sql,
+        .params = &.{
+            .{ .name = "email", .sql_type = "text", .nullable = false, .array = false },
+        },
+        .columns = &.{ "id" },
+    },
+    .{
+        .name = "getUserIDsByIPAddress",
+        .sqlc_name = "GetUserIDsByIPAddress",
+        .file = "users.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_user_i_ds_by_ip_address_sql,
+        .params = &.{
+            .{ .name = "ip_address", .sql_type = "inet", .nullable = true, .array = false },
+        },
+        .columns = &.{ "id" },
+    },
+    .{
+        .name = "getUserIDsByRole",
+        .sqlc_name = "GetUserIDsByRole",
+        .file = "users.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_user_i_ds_by_role_sql,
+        .params = &.{
+            .{ .name = "role", .sql_type = "user_role", .nullable = false, .array = false },
+        },
+        .columns = &.{ "id" },
+    },
+    .{
+        .name = "getUserIDsBySalaryRange",
+        .sqlc_name = "GetUserIDsBySalaryRange",
+        .file = "users.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_user_i_ds_by_salary_range_sql,
+        .params = &.{
+            .{ .name = "salary_1", .sql_type = "numeric", .nullable = true, .array = false },
+            .{ .name = "salary_2", .sql_type = "numeric", .nullable = true, .array = false },
+        },
+        .columns = &.{ "id" },
+    },
+    .{
+        .name = "getUserNames",
+        .sqlc_name = "GetUserNames",
+        .file = "users.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_user_names_sql,
+        .params = &.{},
+        .columns = &.{ "name" },
+    },
+    .{
+        .name = "getUsers",
+        .sqlc_name = "GetUsers",
+        .file = "users.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_users_sql,
+        .params = &.{},
+        .columns = &.{ "id", "name", "email", "password", "role", "ip_address", "salary", "notes", "created_at", "updated_at", "archived_at" },
+    },
+};
+
+// Writes values that std.json cannot write as is in generated jsonStringify
+// methods.
+const json_format = struct {
+    // Writes binary data as a base64 encoded string.
+    fn bytes(jw: anytype, value: []const u8) !void {
+        try jw.beginWriteRaw();
+        try jw.stream.writeByte('"');
+        try std.base64.standard.Encoder.encodeWriter(jw.stream, value);
+        try jw.stream.writeByte('"');
+        jw.endWriteRaw();
+    }
+
+    // Writes a UUID in its canonical form, e.g.
+    // "123e4567-e89b-12d3-a456-426614174000".
+    fn uuid(jw: anytype, value: [16]u8) !void {
+        const hex = "0123456789abcdef";
+        var buf: [36]u8 = undefined;
+        var i: usize = 0;
+        for (value, 0..) |byte, idx| {
+            if (idx == 4 or idx == 6 or idx == 8 or idx == 10) {
+                buf[i] = '-';
+                i += 1;
+            }
+            buf[i] = hex[byte >> 4];
+            buf[i + 1] = hex[byte & 0x0f];
+            i += 2;
+        }
+        try jw.write(buf[0..]);
+    }
+
+    // Writes a timestamp in microseconds since the Unix epoch as an RFC 3339
+    // string in UTC, e.g. "2024-01-02T03:04:05.000006Z". Timestamps before the
+    // epoch are written as numbers.
+    fn timestamp(jw: anytype, value: i64) !void {
+        if (value < 0) {
+            return jw.write(value);
+        }
+        try jw.beginWriteRaw();
+        try jw.stream.writeByte('"');
+        try printTimestamp(jw.stream, @intCast(value));
+        try jw.stream.writeByte('"');
+        jw.endWriteRaw();
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn array(jw: anytype, values: anytype, comptime writeValue: anytype) !void {
+        try jw.beginArray();
+        for (values) |value| {
+            try writeValue(jw, value);
+        }
+        try jw.endArray();
+    }
+};
+
+// Walks the fields of generated structs for the clone, eql and hash methods.
+// Slices are followed and the internal allocator field is skipped.
+const deep = struct {
+    fn clone(allocator: Allocator, value: anytype) !@TypeOf(value) {
+        const T = @TypeOf(value);
+        switch (@typeInfo(T)) {
+            .optional => return if (value) |v| try clone(allocator, v) else null,
+            .pointer => |ptr| {
+                switch (@typeInfo(ptr.child)) {
+                    .optional, .pointer, .@"struct" => {},
+                    else => return allocator.dupe(ptr.child, value),
+                }
+                const out = try allocator.alloc(ptr.child, value.len);
+                errdefer allocator.free(out);
+                var cloned: usize = 0;
+                errdefer for (out[0..cloned]) |item| free(allocator, item);
+                for (value) |item| {
+                    out[cloned] = try clone(allocator, item);
+                    cloned += 1;
+                }
+                return out;
+            },
+            .@"struct" => |info| {
+                var out = value;
+                var cloned: usize = 0;
+                errdefer inline for (info.fields, 0..) |field, i| {
+                    if (i < cloned and !comptime isAllocator(field.name)) {
+                        free(allocator, @field(out, field.name));
+                    }
+                }
+                inline for (info.fields) |field| {
+                    if (comptime isAllocator(field.name)) {
+                        out.__allocator = allocator;
+                    } else {
+                        @field(out, field.name) = try clone(allocator, @field(value, field.name));
+                    }
+                    cloned += 1;
+                }
+                return out;
+            },
+            else => return value,
+        }
+    }
+
+    fn free(allocator: Allocator, value: anytype) void {
+        switch (@typeInfo(@TypeOf(value))) {
+            .optional => if (value) |v| free(allocator, v),
+            .pointer => {
+                for (value) |item| free(allocator, item);
+                allocator.free(value);
+            },
+            .@"struct" => |info| inline for (info.fields) |field| {
+                if (!comptime isAllocator(field.name)) {
+                    free(allocator, @field(value, field.name));
+                }
+            },
+            else => {},
+        }
+    }
+
+    fn eql(a: anytype, b: @TypeOf(a)) bool {
+        switch (@typeInfo(@TypeOf(a))) {
+            .optional => {
+                const x = a orelse return b == null;
+                const y = b orelse return false;
+                return eql(x, y);
+            },
+            .pointer => {
+                if (a.len != b.len) {
+                    return false;
+                }
+                for (a, b) |x, y| {
+                    if (!eql(x, y)) {
+                        return false;
+                    }
+                }
+                return true;
+            },
+            .array => return std.mem.eql(@typeInfo(@TypeOf(a)).array.child, &a, &b),
+            .@"struct" => |info| {
+                inline for (info.fields) |field| {
+                    if (!comptime isAllocator(field.name) and !eql(@field(a, field.name), @field(b, field.name))) {
+                        return false;
+                    }
+                }
+                return true;
+            },
+            else => return a == b,
+        }
+    }
+
+    fn hash(hasher: *std.hash.Wyhash, value: anytype) void {
+        switch (@typeInfo(@TypeOf(value))) {
+            .optional => {
+                hasher.update(&[_]u8{@intFromBool(value != null)});
+                if (value) |v| hash(hasher, v);
+            },
+            .pointer => {
+                hasher.update(std.mem.asBytes(&value.len));
+                for (value) |item| hash(hasher, item);
+            },
+            .@"struct" => |info| inline for (info.fields) |field| {
+                if (!comptime isAllocator(field.name)) {
+                    hash(hasher, @field(value, field.name));
+                }
+            },
+            else => hasher.update(std.mem.asBytes(&value)),
+        }
+    }
+
+    fn isAllocator(comptime name: []const u8) bool {
+        return std.mem.eql(u8, name, "__allocator");
+    }
+};
+
+// Prints the fields of generated structs in format methods.
+const debug_format = struct {
+    fn any(writer: anytype, value: anytype) !void {
+        try writer.print("{any}", .{value});
+    }
+
+    fn string(writer: anytype, value: []const u8) !void {
+        try writer.print("\"{}\"", .{std.zig.fmtEscapes(value)});
+    }
+
+    fn bytes(writer: anytype, value: []const u8) !void {
+        try writer.print("0x{}", .{std.fmt.fmtSliceHexLower(value)});
+    }
+
+    fn uuid(writer: anytype, value: [16]u8) !void {
+        try writer.print("{}-{}-{}-{}-{}", .{
+            std.fmt.fmtSliceHexLower(value[0..4]),
+            std.fmt.fmtSliceHexLower(value[4..6]),
+            std.fmt.fmtSliceHexLower(value[6..8]),
+            std.fmt.fmtSliceHexLower(value[8..10]),
+            std.fmt.fmtSliceHexLower(value[10..16]),
+        });
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC, e.g.
+    // 2024-01-02T03:04:05.000006Z. Timestamps before the epoch are printed as
+    // numbers.
+    fn timestamp(writer: anytype, value: i64) !void {
+        if (value < 0) {
+            return writer.print("{d}", .{value});
+        }
+        try printTimestamp(writer, @intCast(value));
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn @"enum"(writer: anytype, value: anytype) !void {
+        try writer.print(".{s}", .{@tagName(value)});
+    }
+
+    fn cidr(writer: anytype, value: pg.Cidr) !void {
+        if (value.address.len == 4) {
+            try writer.print("{d}.{d}.{d}.{d}", .{ value.address[0], value.address[1], value.address[2], value.address[3] });
+        } else {
+            var i: usize = 0;
+            while (i + 1 < value.address.len) : (i += 2) {
+                if (i > 0) {
+                    try writer.writeByte(':');
+                }
+                try writer.print("{x}", .{@as(u16, value.address[i]) << 8 | value.address[i + 1]});
+            }
+        }
+        try writer.print("/{d}", .{value.netmask});
+    }
+
+    fn numeric(writer: anytype, value: pg.Numeric) !void {
+        try writer.print("{d}", .{value.toFloat()});
+    }
+
+    fn array(writer: anytype, values: anytype, comptime formatValue: anytype) !void {
+        try writer.writeAll("{ ");
+        for (values, 0..) |value, i| {
+            if (i > 0) {
+                try writer.writeAll(", ");
+            }
+            try formatValue(writer, value);
+        }
+        try writer.writeAll(" }");
+    }
+};
+
+// The statement_timeout of a connection before a query with a timeout replaced
+// it. Inside a transaction block the timeout is only set for the transaction,
+// so that a transaction aborted by the query reverts it when rolled back.
+const StatementTimeout = struct {
+    buf: [32]u8 = undefined,
+    len: usize = 0,
+    local: bool = false,
+
+    // Sets the statement_timeout of conn and returns the previous value, in a
+    // single round trip. Outside a transaction block, the statement and
+    // transaction timestamps are the same.
+    fn set(conn: *pg.Conn, timeout: []const u8) !StatementTimeout {
+        const result = try conn.query(
+            \\SELECT current_setting('statement_timeout'),
+            \\  set_config('statement_timeout', $1, statement_timestamp() <> transaction_timestamp()),
+            \\  statement_timestamp() <> transaction_timestamp()
+        , .{timeout});
+        defer result.deinit();
+        const row = (try result.next()) orelse unreachable;
+        const current = row.get([]const u8, 0);
+        var previous = StatementTimeout{ .local = row.get(bool, 2) };
+        if (current.len > previous.buf.len) {
+            return error.InvalidStatementTimeout;
+        }
+        @memcpy(previous.buf[0..current.len], current);
+        previous.len = current.len;
+        return previous;
+    }
+
+    // Restores the statement_timeout of conn saved by set. A transaction
+    // aborted by the query cannot run it, rolling back restores the timeout
+    // instead.
+    fn restore(self: *const StatementTimeout, conn: *pg.Conn) !void {
+        const result = conn.query("SELECT set_config('statement_timeout', $1, $2)", .{ self.buf[0..self.len], self.local }) catch |err| {
+            if (self.local and err == error.PG) {
+                const pge = conn.err orelse return err;
+                // in_failed_sql_transaction
+                if (std.mem.eql(u8, pge.code, "25P02")) {
+                    return;
+                }
+            }
+            return err;
+        };
+        defer result.deinit();
+    }
+};
+
+// Reports whether err was caused by a query exceeding its statement_timeout.
+fn isTimeout(conn: *pg.Conn, err: anyerror) bool {
+    if (err != error.PG) {
+        return false;
+    }
+    const pge = conn.err orelse return false;
+    // query_canceled
+    return std.mem.eql(u8, pge.code, "57014");
+}
+
+// Returns error.Timeout in place of err if it was caused by a query exceeding
+// its statement_timeout.
+fn timeoutError(conn: *pg.Conn, err: anytype) @TypeOf(err) || error{Timeout} {
+    return if (isTimeout(conn, err)) error.Timeout else err;
+}
+
+// Hooks for a Querier that does not observe its queries.
+pub const NoHooks = struct {};
+
+// Calls the optional callbacks declared by Hooks around a query:
+//
+//   pub fn beforeQuery(name: []const u8, sql: []const u8) void
+//   pub fn afterQuery(name: []const u8, duration_ns: u64, rows: ?usize, err: ?anyerror) void
+//
+// The name is the name of the query method. Rows is the number of rows
+// returned, or null for methods passing rows to a context. Callbacks that are
+// not declared are not called, and their overhead compiles away.
+fn QueryHook(comptime Hooks: type) type {
+    return struct {
+        name: []const u8,
+        start: if (@hasDecl(Hooks, "afterQuery")) i128 else void,
+
+        fn begin(name: []const u8, sql: []const u8) @This() {
+            if (@hasDecl(Hooks, "beforeQuery")) {
+                Hooks.beforeQuery(name, sql);
+            }
+            return .{
+                .name = name,
+                .start = if (@hasDecl(Hooks, "afterQuery")) std.time.nanoTimestamp() else {},
+            };
+        }
+
+        fn end(self: @This(), rows: ?usize, err: ?anyerror) void {
+            if (@hasDecl(Hooks, "afterQuery")) {
+                const duration: u64 = @intCast(@max(0, std.time.nanoTimestamp() - self.start));
+                Hooks.afterQuery(self.name, duration, rows, err);
+            }
+        }
+    };
+}
+
+pub const ConnQuerierInterface = QuerierInterface(*pg.Conn, NoHooks);
+pub const PoolQuerierInterface = QuerierInterface(*pg.Pool, NoHooks);
+
+// A type erased interface over the methods of Querier(T). It can be backed by
+// a Querier or any other type declaring the same methods, such as a mock.
+// Methods taking a context are not part of the interface.
+pub fn QuerierInterface(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        ptr: *anyopaque,
+        vtable: *const VTable,
+
+        pub const VTable = struct {
+            createUser: *const fn (ptr: *anyopaque, create_user_params: Querier(T, Hooks).CreateUserParams) anyerror!Querier(T, Hooks).CreateUserResult,
+            getUser: *const fn (ptr: *anyopaque, id: i32) anyerror!Querier(T, Hooks).GetUserResult,
+            getUserEmails: *const fn (ptr: *anyopaque) anyerror!Querier(T, Hooks).GetUserEmailsResult,
+            getUserIDByEmail: *const fn (ptr: *anyopaque, email: []const u8) anyerror!Querier(T, Hooks).GetUserIDByEmailResult,
+            getUserIDsByIPAddress: *const fn (ptr: *anyopaque, ip_address: []const u8) anyerror!Querier(T, Hooks).GetUserIDsByIPAddressResult,
+            getUserIDsByRole: *const fn (ptr: *anyopaque, role: models.UserRole) anyerror!Querier(T, Hooks).GetUserIDsByRoleResult,
+            getUserIDsBySalaryRange: *const fn (ptr: *anyopaque, salary_1: f64, salary_2: f64) anyerror!Querier(T, Hooks).GetUserIDsBySalaryRangeResult,
+            getUserNames: *const fn (ptr: *anyopaque) anyerror!Querier(T, Hooks).GetUserNamesResult,
+            getUsers: *const fn (ptr: *anyopaque) anyerror!Querier(T, Hooks).GetUsersResult,
+        };
+
+        // Returns an interface calling the methods of impl.
+        pub fn init(comptime Impl: type, impl: *Impl) Self {
+            return .{ .ptr = impl, .vtable = &VTableFor(Impl).vtable };
+        }
+
+        fn VTableFor(comptime Impl: type) type {
+            return struct {
+                const vtable = VTable{
+                    .createUser = struct {
+                        fn call(ptr: *anyopaque, create_user_params: Querier(T, Hooks).CreateUserParams) anyerror!Querier(T, Hooks).CreateUserResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.createUser(create_user_params);
+                        }
+                    }.call,
+                    .getUser = struct {
+                        fn call(ptr: *anyopaque, id: i32) anyerror!Querier(T, Hooks).GetUserResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUser(id);
+                        }
+                    }.call,
+                    .getUserEmails = struct {
+                        fn call(ptr: *anyopaque) anyerror!Querier(T, Hooks).GetUserEmailsResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserEmails();
+                        }
+                    }.call,
+                    .getUserIDByEmail = struct {
+                        fn call(ptr: *anyopaque, email: []const u8) anyerror!Querier(T, Hooks).GetUserIDByEmailResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserIDByEmail(email);
+                        }
+                    }.call,
+                    .getUserIDsByIPAddress = struct {
+                        fn call(ptr: *anyopaque, ip_address: []const u8) anyerror!Querier(T, Hooks).GetUserIDsByIPAddressResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserIDsByIPAddress(ip_address);
+                        }
+                    }.call,
+                    .getUserIDsByRole = struct {
+                        fn call(ptr: *anyopaque, role: models.UserRole) anyerror!Querier(T, Hooks).GetUserIDsByRoleResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserIDsByRole(role);
+                        }
+                    }.call,
+                    .getUserIDsBySalaryRange = struct {
+                        fn call(ptr: *anyopaque, salary_1: f64, salary_2: f64) anyerror!Querier(T, Hooks).GetUserIDsBySalaryRangeResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserIDsBySalaryRange(salary_1, salary_2);
+                        }
+                    }.call,
+                    .getUserNames = struct {
+                        fn call(ptr: *anyopaque) anyerror!Querier(T, Hooks).GetUserNamesResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserNames();
+                        }
+                    }.call,
+                    .getUsers = struct {
+                        fn call(ptr: *anyopaque) anyerror!Querier(T, Hooks).GetUsersResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUsers();
+                        }
+                    }.call,
+                };
+            };
+        }
+
+        pub fn createUser(self: Self, create_user_params: Querier(T, Hooks).CreateUserParams) anyerror!Querier(T, Hooks).CreateUserResult {
+            return self.vtable.createUser(self.ptr, create_user_params);
+        }
+
+        pub fn getUser(self: Self, id: i32) anyerror!Querier(T, Hooks).GetUserResult {
+            return self.vtable.getUser(self.ptr, id);
+        }
+
+        pub fn getUserEmails(self: Self) anyerror!Querier(T, Hooks).GetUserEmailsResult {
+            return self.vtable.getUserEmails(self.ptr);
+        }
+
+        pub fn getUserIDByEmail(self: Self, email: []const u8) anyerror!Querier(T, Hooks).GetUserIDByEmailResult {
+            return self.vtable.getUserIDByEmail(self.ptr, email);
+        }
+
+        pub fn getUserIDsByIPAddress(self: Self, ip_address: []const u8) anyerror!Querier(T, Hooks).GetUserIDsByIPAddressResult {
+            return self.vtable.getUserIDsByIPAddress(self.ptr, ip_address);
+        }
+
+        pub fn getUserIDsByRole(self: Self, role: models.UserRole) anyerror!Querier(T, Hooks).GetUserIDsByRoleResult {
+            return self.vtable.getUserIDsByRole(self.ptr, role);
+        }
+
+        pub fn getUserIDsBySalaryRange(self: Self, salary_1: f64, salary_2: f64) anyerror!Querier(T, Hooks).GetUserIDsBySalaryRangeResult {
+            return self.vtable.getUserIDsBySalaryRange(self.ptr, salary_1, salary_2);
+        }
+
+        pub fn getUserNames(self: Self) anyerror!Querier(T, Hooks).GetUserNamesResult {
+            return self.vtable.getUserNames(self.ptr);
+        }
+
+        pub fn getUsers(self: Self) anyerror!Querier(T, Hooks).GetUsersResult {
+            return self.vtable.getUsers(self.ptr);
+        }
+    };
+}
+
+pub const ConnMockQuerier = MockQuerier(*pg.Conn, NoHooks);
+pub const PoolMockQuerier = MockQuerier(*pg.Pool, NoHooks);
+
+// Records the calls to a single MockQuerier method and the result to return.
+pub fn MockMethod(comptime Args: type, comptime Result: type) type {
+    return struct {
+        // The value returned by the method. Methods returning void succeed
+        // without a result.
+        result: ?Result = null,
+        // An error returned by the method instead of the result.
+        err: ?anyerror = null,
+        // Computes the result of the method from its arguments. Takes
+        // precedence over result and err when set.
+        handler: ?*const fn (args: Args) anyerror!Result = null,
+        // The number of calls expected by MockQuerier.verify, or null to
+        // accept any number of calls.
+        expected_calls: ?usize = null,
+        // The number of times the method was called.
+        calls: usize = 0,
+        // The arguments of the most recent call.
+        last_args: ?Args = null,
+
+        fn call(self: *@This(), args: Args) anyerror!Result {
+            self.calls += 1;
+            self.last_args = args;
+            if (self.handler) |handler| {
+                return handler(args);
+            }
+            if (self.err) |err| {
+                return err;
+            }
+            if (Result == void) {
+                return;
+            }
+            return self.result orelse error.UnexpectedCall;
+        }
+    };
+}
+
+// A mock implementation of the methods of Querier(T) for unit tests. Canned
+// results and expectations are set per method on the expect field, calls
+// without a result set return error.UnexpectedCall unless the method returns
+// void.
+pub fn MockQuerier(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        expect: struct {
+            createUser: MockMethod(struct { create_user_params: Querier(T, Hooks).CreateUserParams }, Querier(T, Hooks).CreateUserResult) = .{},
+            getUser: MockMethod(struct { id: i32 }, Querier(T, Hooks).GetUserResult) = .{},
+            getUserEmails: MockMethod(struct {}, Querier(T, Hooks).GetUserEmailsResult) = .{},
+            getUserIDByEmail: MockMethod(struct { email: []const u8 }, Querier(T, Hooks).GetUserIDByEmailResult) = .{},
+            getUserIDsByIPAddress: MockMethod(struct { ip_address: []const u8 }, Querier(T, Hooks).GetUserIDsByIPAddressResult) = .{},
+            getUserIDsByRole: MockMethod(struct { role: models.UserRole }, Querier(T, Hooks).GetUserIDsByRoleResult) = .{},
+            getUserIDsBySalaryRange: MockMethod(struct { salary_1: f64, salary_2: f64 }, Querier(T, Hooks).GetUserIDsBySalaryRangeResult) = .{},
+            getUserNames: MockMethod(struct {}, Querier(T, Hooks).GetUserNamesResult) = .{},
+            getUsers: MockMethod(struct {}, Querier(T, Hooks).GetUsersResult) = .{},
+        } = .{},
+
+        pub fn interface(self: *Self) QuerierInterface(T, Hooks) {
+            return QuerierInterface(T, Hooks).init(Self, self);
+        }
+
+        // Returns error.UnexpectedCallCount if any method with expected_calls
+        // set was called a different number of times.
+        pub fn verify(self: Self) !void {
+            inline for (std.meta.fields(@TypeOf(self.expect))) |field| {
+                const method = @field(self.expect, field.name);
+                if (method.expected_calls) |expected| {
+                    if (method.calls != expected) {
+                        return error.UnexpectedCallCount;
+                    }
+                }
+            }
+        }
+
+        pub fn createUser(self: *Self, create_user_params: Querier(T, Hooks).CreateUserParams) anyerror!Querier(T, Hooks).CreateUserResult {
+            return self.expect.createUser.call(.{ .create_user_params = create_user_params });
+        }
+
+        pub fn getUser(self: *Self, id: i32) anyerror!Querier(T, Hooks).GetUserResult {
+            return self.expect.getUser.call(.{ .id = id });
+        }
+
+        pub fn getUserEmails(self: *Self) anyerror!Querier(T, Hooks).GetUserEmailsResult {
+            return self.expect.getUserEmails.call(.{});
+        }
+
+        pub fn getUserIDByEmail(self: *Self, email: []const u8) anyerror!Querier(T, Hooks).GetUserIDByEmailResult {
+            return self.expect.getUserIDByEmail.call(.{ .email = email });
+        }
+
+        pub fn getUserIDsByIPAddress(self: *Self, ip_address: []const u8) anyerror!Querier(T, Hooks).GetUserIDsByIPAddressResult {
+            return self.expect.getUserIDsByIPAddress.call(.{ .ip_address = ip_address });
+        }
+
+        pub fn getUserIDsByRole(self: *Self, role: models.UserRole) anyerror!Querier(T, Hooks).GetUserIDsByRoleResult {
+            return self.expect.getUserIDsByRole.call(.{ .role = role });
+        }
+
+        pub fn getUserIDsBySalaryRange(self: *Self, salary_1: f64, salary_2: f64) anyerror!Querier(T, Hooks).GetUserIDsBySalaryRangeResult {
+            return self.expect.getUserIDsBySalaryRange.call(.{ .salary_1 = salary_1, .salary_2 = salary_2 });
+        }
+
+        pub fn getUserNames(self: *Self) anyerror!Querier(T, Hooks).GetUserNamesResult {
+            return self.expect.getUserNames.call(.{});
+        }
+
+        pub fn getUsers(self: *Self) anyerror!Querier(T, Hooks).GetUsersResult {
+            return self.expect.getUsers.call(.{});
+        }
+    };
+}
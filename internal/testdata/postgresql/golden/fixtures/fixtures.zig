@@ -0,0 +1,109 @@
+// Generated with sqlc v1.28.0
+
+const models = @import("models.zig");
+
+// A row of the api_keys table. Fields default to deterministic values
+// valid for their columns, with nullable columns set to null.
+pub const ApiKey = struct {
+    id: [16]u8 = [_]u8{0} ** 16,
+    user_id: i32 = 0,
+    secret: []const u8 = "",
+    fingerprints: []const []const u8 = &.{},
+    created_at: i64 = 0,
+    expires_at: ?i64 = null,
+
+    const insert_sql = "INSERT INTO \"api_keys\" (\"id\", \"user_id\", \"secret\", \"fingerprints\", \"created_at\", \"expires_at\") VALUES ($1, $2, $3, $4, $5, $6)";
+
+    // Inserts the row using a *pg.Conn or *pg.Pool.
+    pub fn insert(self: ApiKey, conn: anytype) !void {
+        _ = try conn.exec(insert_sql, .{
+            self.id,
+            self.user_id,
+            self.secret,
+            self.fingerprints,
+            self.created_at,
+            self.expires_at,
+        });
+    }
+};
+
+// A row of the invoices table. Fields default to deterministic values
+// valid for their columns, with nullable columns set to null.
+pub const BillingInvoice = struct {
+    user_id: i32 = 0,
+    status: models.BillingInvoiceStatus = @enumFromInt(0),
+    amount: f64 = 0,
+    memo: ?[]const u8 = null,
+
+    const insert_sql = "INSERT INTO \"billing\".\"invoices\" (\"user_id\", \"status\", \"amount\", \"memo\") VALUES ($1, $2, $3, $4)";
+
+    // Inserts the row using a *pg.Conn or *pg.Pool.
+    pub fn insert(self: BillingInvoice, conn: anytype) !void {
+        _ = try conn.exec(insert_sql, .{
+            self.user_id,
+            self.status,
+            self.amount,
+            self.memo,
+        });
+    }
+};
+
+// A row of the orders table. Fields default to deterministic values
+// valid for their columns, with nullable columns set to null.
+pub const Order = struct {
+    order_date: i64 = 0,
+    item_ids: []const i32 = &.{},
+    products: []const models.Product = &.{},
+    item_quantities: []const f64 = &.{},
+    shipping_addresses: []const []const u8 = &.{},
+    ip_addresses: []const []const u8 = &.{},
+    total_amount: f64 = 0,
+
+    const insert_sql = "INSERT INTO \"orders\" (\"order_date\", \"item_ids\", \"products\", \"item_quantities\", \"shipping_addresses\", \"ip_addresses\", \"total_amount\") VALUES ($1, $2, $3, $4, $5, $6, $7)";
+
+    // Inserts the row using a *pg.Conn or *pg.Pool.
+    pub fn insert(self: Order, conn: anytype) !void {
+        _ = try conn.exec(insert_sql, .{
+            self.order_date,
+            self.item_ids,
+            self.products,
+            self.item_quantities,
+            self.shipping_addresses,
+            self.ip_addresses,
+            self.total_amount,
+        });
+    }
+};
+
+// A row of the users table. Fields default to deterministic values
+// valid for their columns, with nullable columns set to null.
+pub const User = struct {
+    name: []const u8 = "name",
+    email: []const u8 = "email",
+    password: []const u8 = "password",
+    role: models.UserRole = @enumFromInt(0),
+    ip_address: ?[]const u8 = null,
+    salary: ?f64 = null,
+    notes: ?[]const u8 = null,
+    created_at: i64 = 0,
+    updated_at: i64 = 0,
+    archived_at: ?i64 = null,
+
+    const insert_sql = "INSERT INTO \"users\" (\"name\", \"email\", \"password\", \"role\", \"ip_address\", \"salary\", \"notes\", \"created_at\", \"updated_at\", \"archived_at\") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)";
+
+    // Inserts the row using a *pg.Conn or *pg.Pool.
+    pub fn insert(self: User, conn: anytype) !void {
+        _ = try conn.exec(insert_sql, .{
+            self.name,
+            self.email,
+            self.password,
+            self.role,
+            self.ip_address,
+            self.salary,
+            self.notes,
+            self.created_at,
+            self.updated_at,
+            self.archived_at,
+        });
+    }
+};
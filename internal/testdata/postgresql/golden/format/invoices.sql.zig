@@ -0,0 +1,252 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+        
+        const create_invoice_sql = 
+            \\INSERT INTO billing.invoices (user_id, status, amount, memo) VALUES ($1, $2, $3, $4)
+        ;
+
+        pub const CreateInvoiceParams = struct {
+            user_id: i32,
+            status: models.BillingInvoiceStatus,
+            amount: f64,
+            memo: ?[]const u8 = null,
+
+            pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+                _ = fmt;
+                _ = options;
+                try writer.writeAll("CreateInvoiceParams{ .user_id = ");
+                try debug_format.any(writer, self.user_id);
+                try writer.writeAll(", .status = ");
+                try debug_format.@"enum"(writer, self.status);
+                try writer.writeAll(", .amount = ");
+                try debug_format.any(writer, self.amount);
+                try writer.writeAll(", .memo = ");
+                if (self.memo) |value| {
+                    try debug_format.string(writer, value);
+                } else {
+                    try writer.writeAll("null");
+                }
+                try writer.writeAll(" }");
+            }
+        };
+
+        pub fn createInvoice(self: Self, create_invoice_params: CreateInvoiceParams) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            _ = try conn.exec(create_invoice_sql, .{ 
+                create_invoice_params.user_id,
+                create_invoice_params.status,
+                create_invoice_params.amount,
+                create_invoice_params.memo,
+            });
+        }
+
+        const get_invoice_status_sql = 
+            \\SELECT status FROM billing.invoices
+            \\WHERE id = $1
+        ;
+
+        pub fn getInvoiceStatus(self: Self, id: i32) !models.BillingInvoiceStatus {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_invoice_status_sql, .{ 
+                id,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_status = row.get(models.BillingInvoiceStatus, 0);
+
+            return row_status;
+        }
+
+        const get_invoices_sql = 
+            \\SELECT id, user_id, status, amount, memo FROM billing.invoices
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getInvoices(self: Self) ![]models.BillingInvoice {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_invoices_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(models.BillingInvoice).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_user_id = row.get(i32, 1);
+                const row_status = row.get(models.BillingInvoiceStatus, 2);
+                const amount_numeric = row.get(pg.Numeric, 3);const row_amount = pg.Numeric{
+                    .number_of_digits = amount_numeric.number_of_digits,
+                    .weight = amount_numeric.weight,
+                    .sign = amount_numeric.sign,
+                    .scale = amount_numeric.scale,
+                    .digits = try allocator.dupe(u8, amount_numeric.digits),
+                };
+                errdefer allocator.free(row_amount.digits);
+
+                const maybe_memo = row.get(?[]const u8, 4);
+                const row_memo: ?[]const u8 = blk: {
+                    if (maybe_memo) |field| {
+                        break :blk try allocator.dupe(u8, field);
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_memo) |field| {
+                    allocator.free(field);
+                };
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .user_id = row_user_id,
+                    .status = row_status,
+                    .amount = row_amount,
+                    .memo = row_memo,
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getInvoices along with the slice
+        // holding them.
+        pub fn freeGetInvoices(self: Self, rows: []const models.BillingInvoice) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
+
+// Prints the fields of generated structs in format methods.
+const debug_format = struct {
+    fn any(writer: anytype, value: anytype) !void {
+        try writer.print("{any}", .{value});
+    }
+
+    fn string(writer: anytype, value: []const u8) !void {
+        try writer.print("\"{}\"", .{std.zig.fmtEscapes(value)});
+    }
+
+    fn bytes(writer: anytype, value: []const u8) !void {
+        try writer.print("0x{}", .{std.fmt.fmtSliceHexLower(value)});
+    }
+
+    fn uuid(writer: anytype, value: [16]u8) !void {
+        try writer.print("{}-{}-{}-{}-{}", .{
+            std.fmt.fmtSliceHexLower(value[0..4]),
+            std.fmt.fmtSliceHexLower(value[4..6]),
+            std.fmt.fmtSliceHexLower(value[6..8]),
+            std.fmt.fmtSliceHexLower(value[8..10]),
+            std.fmt.fmtSliceHexLower(value[10..16]),
+        });
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC, e.g.
+    // 2024-01-02T03:04:05.000006Z. Timestamps before the epoch are printed as
+    // numbers.
+    fn timestamp(writer: anytype, value: i64) !void {
+        if (value < 0) {
+            return writer.print("{d}", .{value});
+        }
+        try printTimestamp(writer, @intCast(value));
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn @"enum"(writer: anytype, value: anytype) !void {
+        try writer.print(".{s}", .{@tagName(value)});
+    }
+
+    fn cidr(writer: anytype, value: pg.Cidr) !void {
+        if (value.address.len == 4) {
+            try writer.print("{d}.{d}.{d}.{d}", .{ value.address[0], value.address[1], value.address[2], value.address[3] });
+        } else {
+            var i: usize = 0;
+            while (i + 1 < value.address.len) : (i += 2) {
+                if (i > 0) {
+                    try writer.writeByte(':');
+                }
+                try writer.print("{x}", .{@as(u16, value.address[i]) << 8 | value.address[i + 1]});
+            }
+        }
+        try writer.print("/{d}", .{value.netmask});
+    }
+
+    fn numeric(writer: anytype, value: pg.Numeric) !void {
+        try writer.print("{d}", .{value.toFloat()});
+    }
+
+    fn array(writer: anytype, values: anytype, comptime formatValue: anytype) !void {
+        try writer.writeAll("{ ");
+        for (values, 0..) |value, i| {
+            if (i > 0) {
+                try writer.writeAll(", ");
+            }
+            try formatValue(writer, value);
+        }
+        try writer.writeAll(" }");
+    }
+};
@@ -0,0 +1,314 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+
+pub const BillingInvoiceStatus = enum {
+    @"draft",
+    @"paid",
+};
+
+pub const Product = enum {
+    @"laptop",
+    @"desktop",
+    @"mobile",
+    @"tablet",
+};
+
+pub const UserRole = enum {
+    @"admin",
+    @"user",
+};
+
+pub const ApiKey = struct {
+    __allocator: Allocator,
+
+    id: [16]u8,
+    user_id: i32,
+    secret: []u8,
+    fingerprints: [][]u8,
+    created_at: i64,
+    expires_at: ?i64 = null,
+
+    pub fn deinit(self: *const ApiKey) void {
+        self.__allocator.free(self.id);
+        self.__allocator.free(self.secret);
+        for (self.fingerprints) |item| {
+            self.__allocator.free(item);
+        }
+        self.__allocator.free(self.fingerprints);
+    }
+
+    pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+        _ = fmt;
+        _ = options;
+        try writer.writeAll("ApiKey{ .id = ");
+        try debug_format.uuid(writer, self.id);
+        try writer.writeAll(", .user_id = ");
+        try debug_format.any(writer, self.user_id);
+        try writer.writeAll(", .secret = <redacted>");
+        try writer.writeAll(", .fingerprints = ");
+        try debug_format.array(writer, self.fingerprints, debug_format.bytes);
+        try writer.writeAll(", .created_at = ");
+        try debug_format.timestamp(writer, self.created_at);
+        try writer.writeAll(", .expires_at = ");
+        if (self.expires_at) |value| {
+            try debug_format.timestamp(writer, value);
+        } else {
+            try writer.writeAll("null");
+        }
+        try writer.writeAll(" }");
+    }
+};
+
+pub const BillingInvoice = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    user_id: i32,
+    status: BillingInvoiceStatus,
+    amount: pg.Numeric,
+    memo: ?[]const u8 = null,
+
+    pub fn deinit(self: *const BillingInvoice) void {
+        self.__allocator.free(self.amount.digits);
+        if (self.memo) |field| {
+            self.__allocator.free(field);
+        }
+    }
+
+    pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+        _ = fmt;
+        _ = options;
+        try writer.writeAll("BillingInvoice{ .id = ");
+        try debug_format.any(writer, self.id);
+        try writer.writeAll(", .user_id = ");
+        try debug_format.any(writer, self.user_id);
+        try writer.writeAll(", .status = ");
+        try debug_format.@"enum"(writer, self.status);
+        try writer.writeAll(", .amount = ");
+        try debug_format.numeric(writer, self.amount);
+        try writer.writeAll(", .memo = ");
+        if (self.memo) |value| {
+            try debug_format.string(writer, value);
+        } else {
+            try writer.writeAll("null");
+        }
+        try writer.writeAll(" }");
+    }
+};
+
+pub const Order = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    order_date: i64,
+    item_ids: []i32,
+    products: []const Product,
+    item_quantities: []pg.Numeric,
+    shipping_addresses: [][]const u8,
+    ip_addresses: []pg.Cidr,
+    total_amount: pg.Numeric,
+
+    pub fn deinit(self: *const Order) void {
+        self.__allocator.free(self.item_ids);
+        self.__allocator.free(self.products);
+        for (self.item_quantities) |item| {
+            self.__allocator.free(item.digits);
+        }
+        self.__allocator.free(self.item_quantities);
+        for (self.shipping_addresses) |item| {
+            self.__allocator.free(item);
+        }
+        self.__allocator.free(self.shipping_addresses);
+        for (self.ip_addresses) |item| {
+            self.__allocator.free(item.address);
+        }
+        self.__allocator.free(self.ip_addresses);
+        self.__allocator.free(self.total_amount.digits);
+    }
+
+    pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+        _ = fmt;
+        _ = options;
+        try writer.writeAll("Order{ .id = ");
+        try debug_format.any(writer, self.id);
+        try writer.writeAll(", .order_date = ");
+        try debug_format.timestamp(writer, self.order_date);
+        try writer.writeAll(", .item_ids = ");
+        try debug_format.array(writer, self.item_ids, debug_format.any);
+        try writer.writeAll(", .products = ");
+        try debug_format.array(writer, self.products, debug_format.@"enum");
+        try writer.writeAll(", .item_quantities = ");
+        try debug_format.array(writer, self.item_quantities, debug_format.numeric);
+        try writer.writeAll(", .shipping_addresses = ");
+        try debug_format.array(writer, self.shipping_addresses, debug_format.string);
+        try writer.writeAll(", .ip_addresses = ");
+        try debug_format.array(writer, self.ip_addresses, debug_format.cidr);
+        try writer.writeAll(", .total_amount = ");
+        try debug_format.numeric(writer, self.total_amount);
+        try writer.writeAll(" }");
+    }
+};
+
+pub const User = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    name: []const u8,
+    email: []const u8,
+    password: []const u8,
+    role: UserRole,
+    ip_address: ?pg.Cidr = null,
+    salary: ?pg.Numeric = null,
+    notes: ?[]const u8 = null,
+    created_at: i64,
+    updated_at: i64,
+    archived_at: ?i64 = null,
+
+    pub fn deinit(self: *const User) void {
+        self.__allocator.free(self.name);
+        self.__allocator.free(self.email);
+        self.__allocator.free(self.password);
+        if (self.ip_address) |field| {
+            self.__allocator.free(field.address);
+        }
+        if (self.salary) |field| {
+            self.__allocator.free(field.digits);
+        }
+        if (self.notes) |field| {
+            self.__allocator.free(field);
+        }
+    }
+
+    pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+        _ = fmt;
+        _ = options;
+        try writer.writeAll("User{ .id = ");
+        try debug_format.any(writer, self.id);
+        try writer.writeAll(", .name = ");
+        try debug_format.string(writer, self.name);
+        try writer.writeAll(", .email = ");
+        try debug_format.string(writer, self.email);
+        try writer.writeAll(", .password = <redacted>");
+        try writer.writeAll(", .role = ");
+        try debug_format.@"enum"(writer, self.role);
+        try writer.writeAll(", .ip_address = ");
+        if (self.ip_address) |value| {
+            try debug_format.cidr(writer, value);
+        } else {
+            try writer.writeAll("null");
+        }
+        try writer.writeAll(", .salary = ");
+        if (self.salary) |value| {
+            try debug_format.numeric(writer, value);
+        } else {
+            try writer.writeAll("null");
+        }
+        try writer.writeAll(", .notes = ");
+        if (self.notes) |value| {
+            try debug_format.string(writer, value);
+        } else {
+            try writer.writeAll("null");
+        }
+        try writer.writeAll(", .created_at = ");
+        try debug_format.timestamp(writer, self.created_at);
+        try writer.writeAll(", .updated_at = ");
+        try debug_format.timestamp(writer, self.updated_at);
+        try writer.writeAll(", .archived_at = ");
+        if (self.archived_at) |value| {
+            try debug_format.timestamp(writer, value);
+        } else {
+            try writer.writeAll("null");
+        }
+        try writer.writeAll(" }");
+    }
+};
+
+// Prints the fields of generated structs in format methods.
+const debug_format = struct {
+    fn any(writer: anytype, value: anytype) !void {
+        try writer.print("{any}", .{value});
+    }
+
+    fn string(writer: anytype, value: []const u8) !void {
+        try writer.print("\"{}\"", .{std.zig.fmtEscapes(value)});
+    }
+
+    fn bytes(writer: anytype, value: []const u8) !void {
+        try writer.print("0x{}", .{std.fmt.fmtSliceHexLower(value)});
+    }
+
+    fn uuid(writer: anytype, value: [16]u8) !void {
+        try writer.print("{}-{}-{}-{}-{}", .{
+            std.fmt.fmtSliceHexLower(value[0..4]),
+            std.fmt.fmtSliceHexLower(value[4..6]),
+            std.fmt.fmtSliceHexLower(value[6..8]),
+            std.fmt.fmtSliceHexLower(value[8..10]),
+            std.fmt.fmtSliceHexLower(value[10..16]),
+        });
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC, e.g.
+    // 2024-01-02T03:04:05.000006Z. Timestamps before the epoch are printed as
+    // numbers.
+    fn timestamp(writer: anytype, value: i64) !void {
+        if (value < 0) {
+            return writer.print("{d}", .{value});
+        }
+        try printTimestamp(writer, @intCast(value));
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn @"enum"(writer: anytype, value: anytype) !void {
+        try writer.print(".{s}", .{@tagName(value)});
+    }
+
+    fn cidr(writer: anytype, value: pg.Cidr) !void {
+        if (value.address.len == 4) {
+            try writer.print("{d}.{d}.{d}.{d}", .{ value.address[0], value.address[1], value.address[2], value.address[3] });
+        } else {
+            var i: usize = 0;
+            while (i + 1 < value.address.len) : (i += 2) {
+                if (i > 0) {
+                    try writer.writeByte(':');
+                }
+                try writer.print("{x}", .{@as(u16, value.address[i]) << 8 | value.address[i + 1]});
+            }
+        }
+        try writer.print("/{d}", .{value.netmask});
+    }
+
+    fn numeric(writer: anytype, value: pg.Numeric) !void {
+        try writer.print("{d}", .{value.toFloat()});
+    }
+
+    fn array(writer: anytype, values: anytype, comptime formatValue: anytype) !void {
+        try writer.writeAll("{ ");
+        for (values, 0..) |value, i| {
+            if (i > 0) {
+                try writer.writeAll(", ");
+            }
+            try formatValue(writer, value);
+        }
+        try writer.writeAll(" }");
+    }
+};
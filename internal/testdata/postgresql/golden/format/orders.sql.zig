@@ -0,0 +1,505 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+        
+        const create_order_sql = 
+            \\INSERT INTO orders (
+            \\    order_date,
+            \\    item_ids,
+            \\    products,
+            \\    item_quantities,
+            \\    shipping_addresses,
+            \\    ip_addresses,
+            \\    total_amount
+            \\) VALUES (
+            \\    $1,
+            \\    $2,
+            \\    $3,
+            \\    $4,
+            \\    $5,
+            \\    $6,
+            \\    $7
+            \\)
+        ;
+
+        pub const CreateOrderParams = struct {
+            order_date: i64,
+            item_ids: []i32,
+            products: []const models.Product,
+            item_quantities: []f64,
+            shipping_addresses: [][]const u8,
+            ip_addresses: [][]const u8,
+            total_amount: f64,
+
+            pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+                _ = fmt;
+                _ = options;
+                try writer.writeAll("CreateOrderParams{ .order_date = ");
+                try debug_format.timestamp(writer, self.order_date);
+                try writer.writeAll(", .item_ids = ");
+                try debug_format.array(writer, self.item_ids, debug_format.any);
+                try writer.writeAll(", .products = ");
+                try debug_format.array(writer, self.products, debug_format.@"enum");
+                try writer.writeAll(", .item_quantities = ");
+                try debug_format.array(writer, self.item_quantities, debug_format.any);
+                try writer.writeAll(", .shipping_addresses = ");
+                try debug_format.array(writer, self.shipping_addresses, debug_format.string);
+                try writer.writeAll(", .ip_addresses = ");
+                try debug_format.array(writer, self.ip_addresses, debug_format.string);
+                try writer.writeAll(", .total_amount = ");
+                try debug_format.any(writer, self.total_amount);
+                try writer.writeAll(" }");
+            }
+        };
+
+        pub fn createOrder(self: Self, create_order_params: CreateOrderParams) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            _ = try conn.exec(create_order_sql, .{ 
+                create_order_params.order_date,
+                create_order_params.item_ids,
+                create_order_params.products,
+                create_order_params.item_quantities,
+                create_order_params.shipping_addresses,
+                create_order_params.ip_addresses,
+                create_order_params.total_amount,
+            });
+        }
+
+        const get_order_by_id_sql = 
+            \\SELECT id, order_date, item_ids, products, item_quantities, shipping_addresses, ip_addresses, total_amount FROM orders
+            \\WHERE id = $1 LIMIT 1
+        ;
+
+        pub fn getOrderByID(self: Self, id: i32) !models.Order {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_order_by_id_sql, .{ 
+                id,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+            const row_order_date = row.get(i64, 1);
+            var row_item_ids = std.ArrayList(i32).init(allocator);
+            defer row_item_ids.deinit();
+            var row_item_ids_iter = row.get(pg.Iterator(i32), 2);
+            while (row_item_ids_iter.next()) |item| {
+                try row_item_ids.append(item);
+            }
+            var row_products = std.ArrayList(models.Product).init(allocator);
+            defer row_products.deinit();
+            var row_products_iter = row.get(pg.Iterator([]const u8), 3);
+            while (row_products_iter.next()) |item| {
+                try row_products.append(std.meta.stringToEnum(models.Product, item) orelse unreachable);
+            }
+            var row_item_quantities = std.ArrayList(pg.Numeric).init(allocator);
+            defer row_item_quantities.deinit();
+            var row_item_quantities_iter = row.get(pg.Iterator(pg.Numeric), 4);
+            while (row_item_quantities_iter.next()) |item| {
+                const digits = try allocator.dupe(u8, item.digits);
+                errdefer allocator.free(digits);
+                try row_item_quantities.append(pg.Numeric{
+                    .number_of_digits = item.number_of_digits,
+                    .weight = item.weight,
+                    .sign = item.sign,
+                    .scale = item.scale,
+                    .digits = digits,
+                });
+            }
+            var row_shipping_addresses = std.ArrayList([]const u8).init(allocator);
+            defer row_shipping_addresses.deinit();
+            var row_shipping_addresses_iter = row.get(pg.Iterator([]const u8), 5);
+            while (row_shipping_addresses_iter.next()) |item| {
+                const value = try allocator.dupe(u8, item);
+                errdefer allocator.free(value);
+                try row_shipping_addresses.append(value);
+            }
+            var row_ip_addresses = std.ArrayList(pg.Cidr).init(allocator);
+            defer row_ip_addresses.deinit();
+            var row_ip_addresses_iter = row.get(pg.Iterator(pg.Cidr), 6);
+            while (row_ip_addresses_iter.next()) |item| {
+                const address = try allocator.dupe(u8, item.address);
+                errdefer allocator.free(address);
+                try row_ip_addresses.append(pg.Cidr{
+                    .address = address,
+                    .netmask = item.netmask,
+                    .family = item.family,
+                });
+            }
+            const total_amount_numeric = row.get(pg.Numeric, 7);const row_total_amount = pg.Numeric{
+                .number_of_digits = total_amount_numeric.number_of_digits,
+                .weight = total_amount_numeric.weight,
+                .sign = total_amount_numeric.sign,
+                .scale = total_amount_numeric.scale,
+                .digits = try allocator.dupe(u8, total_amount_numeric.digits),
+            };
+            errdefer allocator.free(row_total_amount.digits);
+
+            return .{
+                .__allocator = allocator,
+                .id = row_id,
+                .order_date = row_order_date,
+                .item_ids = try row_item_ids.toOwnedSlice(),
+                .products = try row_products.toOwnedSlice(),
+                .item_quantities = try row_item_quantities.toOwnedSlice(),
+                .shipping_addresses = try row_shipping_addresses.toOwnedSlice(),
+                .ip_addresses = try row_ip_addresses.toOwnedSlice(),
+                .total_amount = row_total_amount,
+            };
+        }
+
+        const get_order_partial_sql = 
+            \\SELECT order_date, total_amount, products FROM orders
+        ;
+
+        pub const GetOrderPartialRow = struct {
+            __allocator: Allocator,
+
+            order_date: i64,
+            total_amount: pg.Numeric,
+            products: []const models.Product,
+
+            pub fn deinit(self: *const GetOrderPartialRow) void {
+                self.__allocator.free(self.total_amount.digits);
+            }
+
+            pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+                _ = fmt;
+                _ = options;
+                try writer.writeAll("GetOrderPartialRow{ .order_date = ");
+                try debug_format.timestamp(writer, self.order_date);
+                try writer.writeAll(", .total_amount = ");
+                try debug_format.numeric(writer, self.total_amount);
+                try writer.writeAll(", .products = ");
+                try debug_format.array(writer, self.products, debug_format.@"enum");
+                try writer.writeAll(" }");
+            }
+        };
+
+        pub fn getOrderPartial(self: Self) ![]GetOrderPartialRow {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_order_partial_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(GetOrderPartialRow).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_order_date = row.get(i64, 0);
+                const total_amount_numeric = row.get(pg.Numeric, 1);const row_total_amount = pg.Numeric{
+                    .number_of_digits = total_amount_numeric.number_of_digits,
+                    .weight = total_amount_numeric.weight,
+                    .sign = total_amount_numeric.sign,
+                    .scale = total_amount_numeric.scale,
+                    .digits = try allocator.dupe(u8, total_amount_numeric.digits),
+                };
+                errdefer allocator.free(row_total_amount.digits);
+                var row_products = std.ArrayList(models.Product).init(allocator);
+                defer row_products.deinit();
+                var row_products_iter = row.get(pg.Iterator([]const u8), 2);
+                while (row_products_iter.next()) |item| {
+                    try row_products.append(std.meta.stringToEnum(models.Product, item) orelse unreachable);
+                }
+                try out.append(.{
+                    .__allocator = allocator,
+                    .order_date = row_order_date,
+                    .total_amount = row_total_amount,
+                    .products = try row_products.toOwnedSlice(),
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getOrderPartial along with the slice
+        // holding them.
+        pub fn freeGetOrderPartial(self: Self, rows: []const GetOrderPartialRow) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+        const get_order_totals_sql = 
+            \\SELECT total_amount FROM orders
+        ;
+
+        pub fn getOrderTotals(self: Self) ![]pg.Numeric {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_order_totals_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(pg.Numeric).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const total_amount_numeric = row.get(pg.Numeric, 0);const row_total_amount = pg.Numeric{
+                    .number_of_digits = total_amount_numeric.number_of_digits,
+                    .weight = total_amount_numeric.weight,
+                    .sign = total_amount_numeric.sign,
+                    .scale = total_amount_numeric.scale,
+                    .digits = try allocator.dupe(u8, total_amount_numeric.digits),
+                };
+                errdefer allocator.free(row_total_amount.digits);
+                try out.append(row_total_amount);
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getOrderTotals along with the slice
+        // holding them.
+        pub fn freeGetOrderTotals(self: Self, rows: []const pg.Numeric) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                allocator.free(row.digits);
+            }
+            allocator.free(rows);
+        }
+
+        const get_orders_sql = 
+            \\SELECT id, order_date, item_ids, products, item_quantities, shipping_addresses, ip_addresses, total_amount FROM orders
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getOrders(self: Self) ![]models.Order {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_orders_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(models.Order).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_order_date = row.get(i64, 1);
+                var row_item_ids = std.ArrayList(i32).init(allocator);
+                defer row_item_ids.deinit();
+                var row_item_ids_iter = row.get(pg.Iterator(i32), 2);
+                while (row_item_ids_iter.next()) |item| {
+                    try row_item_ids.append(item);
+                }
+                var row_products = std.ArrayList(models.Product).init(allocator);
+                defer row_products.deinit();
+                var row_products_iter = row.get(pg.Iterator([]const u8), 3);
+                while (row_products_iter.next()) |item| {
+                    try row_products.append(std.meta.stringToEnum(models.Product, item) orelse unreachable);
+                }
+                var row_item_quantities = std.ArrayList(pg.Numeric).init(allocator);
+                defer row_item_quantities.deinit();
+                var row_item_quantities_iter = row.get(pg.Iterator(pg.Numeric), 4);
+                while (row_item_quantities_iter.next()) |item| {
+                    const digits = try allocator.dupe(u8, item.digits);
+                    errdefer allocator.free(digits);
+                    try row_item_quantities.append(pg.Numeric{
+                        .number_of_digits = item.number_of_digits,
+                        .weight = item.weight,
+                        .sign = item.sign,
+                        .scale = item.scale,
+                        .digits = digits,
+                    });
+                }
+                var row_shipping_addresses = std.ArrayList([]const u8).init(allocator);
+                defer row_shipping_addresses.deinit();
+                var row_shipping_addresses_iter = row.get(pg.Iterator([]const u8), 5);
+                while (row_shipping_addresses_iter.next()) |item| {
+                    const value = try allocator.dupe(u8, item);
+                    errdefer allocator.free(value);
+                    try row_shipping_addresses.append(value);
+                }
+                var row_ip_addresses = std.ArrayList(pg.Cidr).init(allocator);
+                defer row_ip_addresses.deinit();
+                var row_ip_addresses_iter = row.get(pg.Iterator(pg.Cidr), 6);
+                while (row_ip_addresses_iter.next()) |item| {
+                    const address = try allocator.dupe(u8, item.address);
+                    errdefer allocator.free(address);
+                    try row_ip_addresses.append(pg.Cidr{
+                        .address = address,
+                        .netmask = item.netmask,
+                        .family = item.family,
+                    });
+                }
+                const total_amount_numeric = row.get(pg.Numeric, 7);const row_total_amount = pg.Numeric{
+                    .number_of_digits = total_amount_numeric.number_of_digits,
+                    .weight = total_amount_numeric.weight,
+                    .sign = total_amount_numeric.sign,
+                    .scale = total_amount_numeric.scale,
+                    .digits = try allocator.dupe(u8, total_amount_numeric.digits),
+                };
+                errdefer allocator.free(row_total_amount.digits);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .order_date = row_order_date,
+                    .item_ids = try row_item_ids.toOwnedSlice(),
+                    .products = try row_products.toOwnedSlice(),
+                    .item_quantities = try row_item_quantities.toOwnedSlice(),
+                    .shipping_addresses = try row_shipping_addresses.toOwnedSlice(),
+                    .ip_addresses = try row_ip_addresses.toOwnedSlice(),
+                    .total_amount = row_total_amount,
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getOrders along with the slice
+        // holding them.
+        pub fn freeGetOrders(self: Self, rows: []const models.Order) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
+
+// Prints the fields of generated structs in format methods.
+const debug_format = struct {
+    fn any(writer: anytype, value: anytype) !void {
+        try writer.print("{any}", .{value});
+    }
+
+    fn string(writer: anytype, value: []const u8) !void {
+        try writer.print("\"{}\"", .{std.zig.fmtEscapes(value)});
+    }
+
+    fn bytes(writer: anytype, value: []const u8) !void {
+        try writer.print("0x{}", .{std.fmt.fmtSliceHexLower(value)});
+    }
+
+    fn uuid(writer: anytype, value: [16]u8) !void {
+        try writer.print("{}-{}-{}-{}-{}", .{
+            std.fmt.fmtSliceHexLower(value[0..4]),
+            std.fmt.fmtSliceHexLower(value[4..6]),
+            std.fmt.fmtSliceHexLower(value[6..8]),
+            std.fmt.fmtSliceHexLower(value[8..10]),
+            std.fmt.fmtSliceHexLower(value[10..16]),
+        });
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC, e.g.
+    // 2024-01-02T03:04:05.000006Z. Timestamps before the epoch are printed as
+    // numbers.
+    fn timestamp(writer: anytype, value: i64) !void {
+        if (value < 0) {
+            return writer.print("{d}", .{value});
+        }
+        try printTimestamp(writer, @intCast(value));
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn @"enum"(writer: anytype, value: anytype) !void {
+        try writer.print(".{s}", .{@tagName(value)});
+    }
+
+    fn cidr(writer: anytype, value: pg.Cidr) !void {
+        if (value.address.len == 4) {
+            try writer.print("{d}.{d}.{d}.{d}", .{ value.address[0], value.address[1], value.address[2], value.address[3] });
+        } else {
+            var i: usize = 0;
+            while (i + 1 < value.address.len) : (i += 2) {
+                if (i > 0) {
+                    try writer.writeByte(':');
+                }
+                try writer.print("{x}", .{@as(u16, value.address[i]) << 8 | value.address[i + 1]});
+            }
+        }
+        try writer.print("/{d}", .{value.netmask});
+    }
+
+    fn numeric(writer: anytype, value: pg.Numeric) !void {
+        try writer.print("{d}", .{value.toFloat()});
+    }
+
+    fn array(writer: anytype, values: anytype, comptime formatValue: anytype) !void {
+        try writer.writeAll("{ ");
+        for (values, 0..) |value, i| {
+            if (i > 0) {
+                try writer.writeAll(", ");
+            }
+            try formatValue(writer, value);
+        }
+        try writer.writeAll(" }");
+    }
+};
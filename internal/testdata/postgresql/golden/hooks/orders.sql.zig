@@ -0,0 +1,571 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn, NoHooks);
+pub const PoolQuerier = Querier(*pg.Pool, NoHooks);
+
+pub fn Querier(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(T, Hooks) {
+            return QuerierInterface(T, Hooks).init(Self, self);
+        }
+        
+        const create_order_sql = 
+            \\INSERT INTO orders (
+            \\    order_date,
+            \\    item_ids,
+            \\    products,
+            \\    item_quantities,
+            \\    shipping_addresses,
+            \\    ip_addresses,
+            \\    total_amount
+            \\) VALUES (
+            \\    $1,
+            \\    $2,
+            \\    $3,
+            \\    $4,
+            \\    $5,
+            \\    $6,
+            \\    $7
+            \\)
+        ;
+
+        pub const CreateOrderParams = struct {
+            order_date: i64,
+            item_ids: []i32,
+            products: []const models.Product,
+            item_quantities: []f64,
+            shipping_addresses: [][]const u8,
+            ip_addresses: [][]const u8,
+            total_amount: f64,
+        };
+
+        pub fn createOrder(self: Self, create_order_params: CreateOrderParams) !void {
+            const hook = QueryHook(Hooks).begin("createOrder", create_order_sql);
+            const result = self.createOrderUnhooked(create_order_params);
+            if (result) |value| {
+                hook.end(0, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn createOrderUnhooked(self: Self, create_order_params: CreateOrderParams) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            _ = try conn.exec(create_order_sql, .{ 
+                create_order_params.order_date,
+                create_order_params.item_ids,
+                create_order_params.products,
+                create_order_params.item_quantities,
+                create_order_params.shipping_addresses,
+                create_order_params.ip_addresses,
+                create_order_params.total_amount,
+            });
+        }
+
+        const get_order_by_id_sql = 
+            \\SELECT id, order_date, item_ids, products, item_quantities, shipping_addresses, ip_addresses, total_amount FROM orders
+            \\WHERE id = $1 LIMIT 1
+        ;
+
+        pub fn getOrderByID(self: Self, id: i32) !models.Order {
+            const hook = QueryHook(Hooks).begin("getOrderByID", get_order_by_id_sql);
+            const result = self.getOrderByIDUnhooked(id);
+            if (result) |value| {
+                hook.end(1, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getOrderByIDUnhooked(self: Self, id: i32) !models.Order {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_order_by_id_sql, .{ 
+                id,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+            const row_order_date = row.get(i64, 1);
+            var row_item_ids = std.ArrayList(i32).init(allocator);
+            defer row_item_ids.deinit();
+            var row_item_ids_iter = row.get(pg.Iterator(i32), 2);
+            while (row_item_ids_iter.next()) |item| {
+                try row_item_ids.append(item);
+            }
+            var row_products = std.ArrayList(models.Product).init(allocator);
+            defer row_products.deinit();
+            var row_products_iter = row.get(pg.Iterator([]const u8), 3);
+            while (row_products_iter.next()) |item| {
+                try row_products.append(std.meta.stringToEnum(models.Product, item) orelse unreachable);
+            }
+            var row_item_quantities = std.ArrayList(pg.Numeric).init(allocator);
+            defer row_item_quantities.deinit();
+            var row_item_quantities_iter = row.get(pg.Iterator(pg.Numeric), 4);
+            while (row_item_quantities_iter.next()) |item| {
+                const digits = try allocator.dupe(u8, item.digits);
+                errdefer allocator.free(digits);
+                try row_item_quantities.append(pg.Numeric{
+                    .number_of_digits = item.number_of_digits,
+                    .weight = item.weight,
+                    .sign = item.sign,
+                    .scale = item.scale,
+                    .digits = digits,
+                });
+            }
+            var row_shipping_addresses = std.ArrayList([]const u8).init(allocator);
+            defer row_shipping_addresses.deinit();
+            var row_shipping_addresses_iter = row.get(pg.Iterator([]const u8), 5);
+            while (row_shipping_addresses_iter.next()) |item| {
+                const value = try allocator.dupe(u8, item);
+                errdefer allocator.free(value);
+                try row_shipping_addresses.append(value);
+            }
+            var row_ip_addresses = std.ArrayList(pg.Cidr).init(allocator);
+            defer row_ip_addresses.deinit();
+            var row_ip_addresses_iter = row.get(pg.Iterator(pg.Cidr), 6);
+            while (row_ip_addresses_iter.next()) |item| {
+                const address = try allocator.dupe(u8, item.address);
+                errdefer allocator.free(address);
+                try row_ip_addresses.append(pg.Cidr{
+                    .address = address,
+                    .netmask = item.netmask,
+                    .family = item.family,
+                });
+            }
+            const total_amount_numeric = row.get(pg.Numeric, 7);const row_total_amount = pg.Numeric{
+                .number_of_digits = total_amount_numeric.number_of_digits,
+                .weight = total_amount_numeric.weight,
+                .sign = total_amount_numeric.sign,
+                .scale = total_amount_numeric.scale,
+                .digits = try allocator.dupe(u8, total_amount_numeric.digits),
+            };
+            errdefer allocator.free(row_total_amount.digits);
+
+            return .{
+                .__allocator = allocator,
+                .id = row_id,
+                .order_date = row_order_date,
+                .item_ids = try row_item_ids.toOwnedSlice(),
+                .products = try row_products.toOwnedSlice(),
+                .item_quantities = try row_item_quantities.toOwnedSlice(),
+                .shipping_addresses = try row_shipping_addresses.toOwnedSlice(),
+                .ip_addresses = try row_ip_addresses.toOwnedSlice(),
+                .total_amount = row_total_amount,
+            };
+        }
+
+        const get_order_partial_sql = 
+            \\SELECT order_date, total_amount, products FROM orders
+        ;
+
+        pub const GetOrderPartialRow = struct {
+            __allocator: Allocator,
+
+            order_date: i64,
+            total_amount: pg.Numeric,
+            products: []const models.Product,
+
+            pub fn deinit(self: *const GetOrderPartialRow) void {
+                self.__allocator.free(self.total_amount.digits);
+            }
+        };
+
+        pub fn getOrderPartial(self: Self) ![]GetOrderPartialRow {
+            const hook = QueryHook(Hooks).begin("getOrderPartial", get_order_partial_sql);
+            const result = self.getOrderPartialUnhooked();
+            if (result) |value| {
+                hook.end(value.len, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getOrderPartialUnhooked(self: Self) ![]GetOrderPartialRow {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_order_partial_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(GetOrderPartialRow).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_order_date = row.get(i64, 0);
+                const total_amount_numeric = row.get(pg.Numeric, 1);const row_total_amount = pg.Numeric{
+                    .number_of_digits = total_amount_numeric.number_of_digits,
+                    .weight = total_amount_numeric.weight,
+                    .sign = total_amount_numeric.sign,
+                    .scale = total_amount_numeric.scale,
+                    .digits = try allocator.dupe(u8, total_amount_numeric.digits),
+                };
+                errdefer allocator.free(row_total_amount.digits);
+                var row_products = std.ArrayList(models.Product).init(allocator);
+                defer row_products.deinit();
+                var row_products_iter = row.get(pg.Iterator([]const u8), 2);
+                while (row_products_iter.next()) |item| {
+                    try row_products.append(std.meta.stringToEnum(models.Product, item) orelse unreachable);
+                }
+                try out.append(.{
+                    .__allocator = allocator,
+                    .order_date = row_order_date,
+                    .total_amount = row_total_amount,
+                    .products = try row_products.toOwnedSlice(),
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getOrderPartial along with the slice
+        // holding them.
+        pub fn freeGetOrderPartial(self: Self, rows: []const GetOrderPartialRow) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+        const get_order_totals_sql = 
+            \\SELECT total_amount FROM orders
+        ;
+
+        pub fn getOrderTotals(self: Self) ![]pg.Numeric {
+            const hook = QueryHook(Hooks).begin("getOrderTotals", get_order_totals_sql);
+            const result = self.getOrderTotalsUnhooked();
+            if (result) |value| {
+                hook.end(value.len, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getOrderTotalsUnhooked(self: Self) ![]pg.Numeric {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_order_totals_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(pg.Numeric).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const total_amount_numeric = row.get(pg.Numeric, 0);const row_total_amount = pg.Numeric{
+                    .number_of_digits = total_amount_numeric.number_of_digits,
+                    .weight = total_amount_numeric.weight,
+                    .sign = total_amount_numeric.sign,
+                    .scale = total_amount_numeric.scale,
+                    .digits = try allocator.dupe(u8, total_amount_numeric.digits),
+                };
+                errdefer allocator.free(row_total_amount.digits);
+                try out.append(row_total_amount);
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getOrderTotals along with the slice
+        // holding them.
+        pub fn freeGetOrderTotals(self: Self, rows: []const pg.Numeric) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                allocator.free(row.digits);
+            }
+            allocator.free(rows);
+        }
+
+        const get_orders_sql = 
+            \\SELECT id, order_date, item_ids, products, item_quantities, shipping_addresses, ip_addresses, total_amount FROM orders
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getOrders(self: Self) ![]models.Order {
+            const hook = QueryHook(Hooks).begin("getOrders", get_orders_sql);
+            const result = self.getOrdersUnhooked();
+            if (result) |value| {
+                hook.end(value.len, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getOrdersUnhooked(self: Self) ![]models.Order {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_orders_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(models.Order).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_order_date = row.get(i64, 1);
+                var row_item_ids = std.ArrayList(i32).init(allocator);
+                defer row_item_ids.deinit();
+                var row_item_ids_iter = row.get(pg.Iterator(i32), 2);
+                while (row_item_ids_iter.next()) |item| {
+                    try row_item_ids.append(item);
+                }
+                var row_products = std.ArrayList(models.Product).init(allocator);
+                defer row_products.deinit();
+                var row_products_iter = row.get(pg.Iterator([]const u8), 3);
+                while (row_products_iter.next()) |item| {
+                    try row_products.append(std.meta.stringToEnum(models.Product, item) orelse unreachable);
+                }
+                var row_item_quantities = std.ArrayList(pg.Numeric).init(allocator);
+                defer row_item_quantities.deinit();
+                var row_item_quantities_iter = row.get(pg.Iterator(pg.Numeric), 4);
+                while (row_item_quantities_iter.next()) |item| {
+                    const digits = try allocator.dupe(u8, item.digits);
+                    errdefer allocator.free(digits);
+                    try row_item_quantities.append(pg.Numeric{
+                        .number_of_digits = item.number_of_digits,
+                        .weight = item.weight,
+                        .sign = item.sign,
+                        .scale = item.scale,
+                        .digits = digits,
+                    });
+                }
+                var row_shipping_addresses = std.ArrayList([]const u8).init(allocator);
+                defer row_shipping_addresses.deinit();
+                var row_shipping_addresses_iter = row.get(pg.Iterator([]const u8), 5);
+                while (row_shipping_addresses_iter.next()) |item| {
+                    const value = try allocator.dupe(u8, item);
+                    errdefer allocator.free(value);
+                    try row_shipping_addresses.append(value);
+                }
+                var row_ip_addresses = std.ArrayList(pg.Cidr).init(allocator);
+                defer row_ip_addresses.deinit();
+                var row_ip_addresses_iter = row.get(pg.Iterator(pg.Cidr), 6);
+                while (row_ip_addresses_iter.next()) |item| {
+                    const address = try allocator.dupe(u8, item.address);
+                    errdefer allocator.free(address);
+                    try row_ip_addresses.append(pg.Cidr{
+                        .address = address,
+                        .netmask = item.netmask,
+                        .family = item.family,
+                    });
+                }
+                const total_amount_numeric = row.get(pg.Numeric, 7);const row_total_amount = pg.Numeric{
+                    .number_of_digits = total_amount_numeric.number_of_digits,
+                    .weight = total_amount_numeric.weight,
+                    .sign = total_amount_numeric.sign,
+                    .scale = total_amount_numeric.scale,
+                    .digits = try allocator.dupe(u8, total_amount_numeric.digits),
+                };
+                errdefer allocator.free(row_total_amount.digits);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .order_date = row_order_date,
+                    .item_ids = try row_item_ids.toOwnedSlice(),
+                    .products = try row_products.toOwnedSlice(),
+                    .item_quantities = try row_item_quantities.toOwnedSlice(),
+                    .shipping_addresses = try row_shipping_addresses.toOwnedSlice(),
+                    .ip_addresses = try row_ip_addresses.toOwnedSlice(),
+                    .total_amount = row_total_amount,
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getOrders along with the slice
+        // holding them.
+        pub fn freeGetOrders(self: Self, rows: []const models.Order) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
+
+// Hooks for a Querier that does not observe its queries.
+pub const NoHooks = struct {};
+
+// Calls the optional callbacks declared by Hooks around a query:
+//
+//   pub fn beforeQuery(name: []const u8, sql: []const u8) void
+//   pub fn afterQuery(name: []const u8, duration_ns: u64, rows: ?usize, err: ?anyerror) void
+//
+// The name is the name of the query method. Rows is the number of rows
+// returned, or null for methods passing rows to a context. Callbacks that are
+// not declared are not called, and their overhead compiles away.
+fn QueryHook(comptime Hooks: type) type {
+    return struct {
+        name: []const u8,
+        start: if (@hasDecl(Hooks, "afterQuery")) i128 else void,
+
+        fn begin(name: []const u8, sql: []const u8) @This() {
+            if (@hasDecl(Hooks, "beforeQuery")) {
+                Hooks.beforeQuery(name, sql);
+            }
+            return .{
+                .name = name,
+                .start = if (@hasDecl(Hooks, "afterQuery")) std.time.nanoTimestamp() else {},
+            };
+        }
+
+        fn end(self: @This(), rows: ?usize, err: ?anyerror) void {
+            if (@hasDecl(Hooks, "afterQuery")) {
+                const duration: u64 = @intCast(@max(0, std.time.nanoTimestamp() - self.start));
+                Hooks.afterQuery(self.name, duration, rows, err);
+            }
+        }
+    };
+}
+
+pub const ConnQuerierInterface = QuerierInterface(*pg.Conn, NoHooks);
+pub const PoolQuerierInterface = QuerierInterface(*pg.Pool, NoHooks);
+
+// A type erased interface over the methods of Querier(T). It can be backed by
+// a Querier or any other type declaring the same methods, such as a mock.
+// Methods taking a context are not part of the interface.
+pub fn QuerierInterface(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        ptr: *anyopaque,
+        vtable: *const VTable,
+
+        pub const VTable = struct {
+            createOrder: *const fn (ptr: *anyopaque, create_order_params: Querier(T, Hooks).CreateOrderParams) anyerror!void,
+            getOrderByID: *const fn (ptr: *anyopaque, id: i32) anyerror!models.Order,
+            getOrderPartial: *const fn (ptr: *anyopaque) anyerror![]Querier(T, Hooks).GetOrderPartialRow,
+            getOrderTotals: *const fn (ptr: *anyopaque) anyerror![]pg.Numeric,
+            getOrders: *const fn (ptr: *anyopaque) anyerror![]models.Order,
+        };
+
+        // Returns an interface calling the methods of impl.
+        pub fn init(comptime Impl: type, impl: *Impl) Self {
+            return .{ .ptr = impl, .vtable = &VTableFor(Impl).vtable };
+        }
+
+        fn VTableFor(comptime Impl: type) type {
+            return struct {
+                const vtable = VTable{
+                    .createOrder = struct {
+                        fn call(ptr: *anyopaque, create_order_params: Querier(T, Hooks).CreateOrderParams) anyerror!void {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.createOrder(create_order_params);
+                        }
+                    }.call,
+                    .getOrderByID = struct {
+                        fn call(ptr: *anyopaque, id: i32) anyerror!models.Order {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getOrderByID(id);
+                        }
+                    }.call,
+                    .getOrderPartial = struct {
+                        fn call(ptr: *anyopaque) anyerror![]Querier(T, Hooks).GetOrderPartialRow {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getOrderPartial();
+                        }
+                    }.call,
+                    .getOrderTotals = struct {
+                        fn call(ptr: *anyopaque) anyerror![]pg.Numeric {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getOrderTotals();
+                        }
+                    }.call,
+                    .getOrders = struct {
+                        fn call(ptr: *anyopaque) anyerror![]models.Order {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getOrders();
+                        }
+                    }.call,
+                };
+            };
+        }
+
+        pub fn createOrder(self: Self, create_order_params: Querier(T, Hooks).CreateOrderParams) anyerror!void {
+            return self.vtable.createOrder(self.ptr, create_order_params);
+        }
+
+        pub fn getOrderByID(self: Self, id: i32) anyerror!models.Order {
+            return self.vtable.getOrderByID(self.ptr, id);
+        }
+
+        pub fn getOrderPartial(self: Self) anyerror![]Querier(T, Hooks).GetOrderPartialRow {
+            return self.vtable.getOrderPartial(self.ptr);
+        }
+
+        pub fn getOrderTotals(self: Self) anyerror![]pg.Numeric {
+            return self.vtable.getOrderTotals(self.ptr);
+        }
+
+        pub fn getOrders(self: Self) anyerror![]models.Order {
+            return self.vtable.getOrders(self.ptr);
+        }
+    };
+}
@@ -0,0 +1,759 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn, NoHooks);
+pub const PoolQuerier = Querier(*pg.Pool, NoHooks);
+
+pub fn Querier(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(T, Hooks) {
+            return QuerierInterface(T, Hooks).init(Self, self);
+        }
+        
+        const create_user_sql = 
+            \\INSERT INTO users (
+            \\    name, 
+            \\    email, 
+            \\    password, 
+            \\    role, 
+            \\    ip_address,
+            \\    salary,
+            \\    created_at,
+            \\    updated_at
+            \\) VALUES (
+            \\    $1, $2, $3, $4, $5, $6, NOW(), NOW()
+            \\)
+        ;
+
+        pub const CreateUserParams = struct {
+            name: []const u8,
+            email: []const u8,
+            password: []const u8,
+            role: models.UserRole,
+            ip_address: ?[]const u8 = null,
+            salary: ?f64 = null,
+        };
+
+        pub fn createUser(self: Self, create_user_params: CreateUserParams) !void {
+            const hook = QueryHook(Hooks).begin("createUser", create_user_sql);
+            const result = self.createUserUnhooked(create_user_params);
+            if (result) |value| {
+                hook.end(0, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn createUserUnhooked(self: Self, create_user_params: CreateUserParams) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            _ = try conn.exec(create_user_sql, .{ 
+                create_user_params.name,
+                create_user_params.email,
+                create_user_params.password,
+                create_user_params.role,
+                create_user_params.ip_address,
+                create_user_params.salary,
+            });
+        }
+
+        const get_user_sql = 
+            \\SELECT id, name, email, password, role, ip_address, salary, notes, created_at, updated_at, archived_at FROM users
+            \\WHERE id = $1 LIMIT 1
+        ;
+
+        pub fn getUser(self: Self, id: i32) !models.User {
+            const hook = QueryHook(Hooks).begin("getUser", get_user_sql);
+            const result = self.getUserUnhooked(id);
+            if (result) |value| {
+                hook.end(1, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserUnhooked(self: Self, id: i32) !models.User {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_sql, .{ 
+                id,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+            const row_name = try allocator.dupe(u8, row.get([]const u8, 1));
+            errdefer allocator.free(row_name);
+            const row_email = try allocator.dupe(u8, row.get([]const u8, 2));
+            errdefer allocator.free(row_email);
+            const row_password = try allocator.dupe(u8, row.get([]const u8, 3));
+            errdefer allocator.free(row_password);
+            const row_role = row.get(models.UserRole, 4);
+            const ip_address_cidr = row.get(?pg.Cidr, 5);
+            const row_ip_address: ?pg.Cidr = blk: {
+                if (ip_address_cidr) |cidr| {
+                    break :blk pg.Cidr{
+                        .address = try allocator.dupe(u8, cidr.address),
+                        .netmask = cidr.netmask,
+                        .family = cidr.family,
+                    };
+                }
+                break :blk null;
+            };
+            errdefer if (row_ip_address) |cidr| {
+                allocator.free(cidr.address);
+            };
+            const salary_numeric = row.get(?pg.Numeric, 6);
+            const row_salary: ?pg.Numeric = blk: {
+                if (salary_numeric) |numeric| {
+                    break :blk pg.Numeric{
+                        .number_of_digits = numeric.number_of_digits,
+                        .weight = numeric.weight,
+                        .sign = numeric.sign,
+                        .scale = numeric.scale,
+                        .digits = try allocator.dupe(u8, numeric.digits),
+                    };
+                }
+                break :blk null;
+            };
+            errdefer if (row_salary) |numeric| {
+                allocator.free(numeric.digits);
+            };
+
+            const maybe_notes = row.get(?[]const u8, 7);
+            const row_notes: ?[]const u8 = blk: {
+                if (maybe_notes) |field| {
+                    break :blk try allocator.dupe(u8, field);
+                }
+                break :blk null;
+            };
+            errdefer if (row_notes) |field| {
+                allocator.free(field);
+            };
+            const row_created_at = row.get(i64, 8);
+            const row_updated_at = row.get(i64, 9);
+            const row_archived_at = row.get(?i64, 10);
+
+            return .{
+                .__allocator = allocator,
+                .id = row_id,
+                .name = row_name,
+                .email = row_email,
+                .password = row_password,
+                .role = row_role,
+                .ip_address = row_ip_address,
+                .salary = row_salary,
+                .notes = row_notes,
+                .created_at = row_created_at,
+                .updated_at = row_updated_at,
+                .archived_at = row_archived_at,
+            };
+        }
+
+        const get_user_emails_sql = 
+            \\SELECT id, email FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetUserEmailsRow = struct {
+            __allocator: Allocator,
+
+            id: i32,
+            email: []const u8,
+
+            pub fn deinit(self: *const GetUserEmailsRow) void {
+                self.__allocator.free(self.email);
+            }
+        };
+
+        pub fn getUserEmails(self: Self) ![]GetUserEmailsRow {
+            const hook = QueryHook(Hooks).begin("getUserEmails", get_user_emails_sql);
+            const result = self.getUserEmailsUnhooked();
+            if (result) |value| {
+                hook.end(value.len, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserEmailsUnhooked(self: Self) ![]GetUserEmailsRow {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_emails_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(GetUserEmailsRow).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_email = try allocator.dupe(u8, row.get([]const u8, 1));
+                errdefer allocator.free(row_email);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .email = row_email,
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getUserEmails along with the slice
+        // holding them.
+        pub fn freeGetUserEmails(self: Self, rows: []const GetUserEmailsRow) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+        const get_user_id_by_email_sql = 
+            \\SELECT id FROM users
+            \\WHERE email = $1 LIMIT 1
+        ;
+
+        pub fn getUserIDByEmail(self: Self, email: []const u8) !i32 {
+            const hook = QueryHook(Hooks).begin("getUserIDByEmail", get_user_id_by_email_sql);
+            const result = self.getUserIDByEmailUnhooked(email);
+            if (result) |value| {
+                hook.end(1, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserIDByEmailUnhooked(self: Self, email: []const u8) !i32 {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_id_by_email_sql, .{ 
+                email,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+
+            return row_id;
+        }
+
+        const get_user_i_ds_by_ip_address_sql = 
+            \\SELECT id FROM users
+            \\WHERE ip_address = $1
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getUserIDsByIPAddress(self: Self, ip_address: []const u8) ![]i32 {
+            const hook = QueryHook(Hooks).begin("getUserIDsByIPAddress", get_user_i_ds_by_ip_address_sql);
+            const result = self.getUserIDsByIPAddressUnhooked(ip_address);
+            if (result) |value| {
+                hook.end(value.len, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserIDsByIPAddressUnhooked(self: Self, ip_address: []const u8) ![]i32 {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_i_ds_by_ip_address_sql, .{ 
+                ip_address,
+            });
+            defer result.deinit();
+            var out = std.ArrayList(i32).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                try out.append(row_id);
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        const get_user_i_ds_by_role_sql = 
+            \\SELECT id FROM users
+            \\WHERE role = $1
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getUserIDsByRole(self: Self, role: models.UserRole) ![]i32 {
+            const hook = QueryHook(Hooks).begin("getUserIDsByRole", get_user_i_ds_by_role_sql);
+            const result = self.getUserIDsByRoleUnhooked(role);
+            if (result) |value| {
+                hook.end(value.len, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserIDsByRoleUnhooked(self: Self, role: models.UserRole) ![]i32 {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_i_ds_by_role_sql, .{ 
+                role,
+            });
+            defer result.deinit();
+            var out = std.ArrayList(i32).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                try out.append(row_id);
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        const get_user_i_ds_by_salary_range_sql = 
+            \\SELECT id FROM users
+            \\WHERE salary >= $1 AND salary <= $2
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getUserIDsBySalaryRange(self: Self, salary_1: f64, salary_2: f64) ![]i32 {
+            const hook = QueryHook(Hooks).begin("getUserIDsBySalaryRange", get_user_i_ds_by_salary_range_sql);
+            const result = self.getUserIDsBySalaryRangeUnhooked(salary_1, salary_2);
+            if (result) |value| {
+                hook.end(value.len, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserIDsBySalaryRangeUnhooked(self: Self, salary_1: f64, salary_2: f64) ![]i32 {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_i_ds_by_salary_range_sql, .{ 
+                salary_1,
+                salary_2,
+            });
+            defer result.deinit();
+            var out = std.ArrayList(i32).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                try out.append(row_id);
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        const get_user_names_sql = 
+            \\SELECT name FROM users
+        ;
+
+        pub fn getUserNames(self: Self) ![][]const u8 {
+            const hook = QueryHook(Hooks).begin("getUserNames", get_user_names_sql);
+            const result = self.getUserNamesUnhooked();
+            if (result) |value| {
+                hook.end(value.len, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserNamesUnhooked(self: Self) ![][]const u8 {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_user_names_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList([]const u8).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_name = try allocator.dupe(u8, row.get([]const u8, 0));
+                errdefer allocator.free(row_name);
+                try out.append(row_name);
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getUserNames along with the slice
+        // holding them.
+        pub fn freeGetUserNames(self: Self, rows: []const []const u8) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                allocator.free(row);
+            }
+            allocator.free(rows);
+        }
+
+        const get_users_sql = 
+            \\SELECT id, name, email, password, role, ip_address, salary, notes, created_at, updated_at, archived_at FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getUsers(self: Self) ![]models.User {
+            const hook = QueryHook(Hooks).begin("getUsers", get_users_sql);
+            const result = self.getUsersUnhooked();
+            if (result) |value| {
+                hook.end(value.len, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUsersUnhooked(self: Self) ![]models.User {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_users_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(models.User).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_name = try allocator.dupe(u8, row.get([]const u8, 1));
+                errdefer allocator.free(row_name);
+                const row_email = try allocator.dupe(u8, row.get([]const u8, 2));
+                errdefer allocator.free(row_email);
+                const row_password = try allocator.dupe(u8, row.get([]const u8, 3));
+                errdefer allocator.free(row_password);
+                const row_role = row.get(models.UserRole, 4);
+                const ip_address_cidr = row.get(?pg.Cidr, 5);
+                const row_ip_address: ?pg.Cidr = blk: {
+                    if (ip_address_cidr) |cidr| {
+                        break :blk pg.Cidr{
+                            .address = try allocator.dupe(u8, cidr.address),
+                            .netmask = cidr.netmask,
+                            .family = cidr.family,
+                        };
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_ip_address) |cidr| {
+                    allocator.free(cidr.address);
+                };
+                const salary_numeric = row.get(?pg.Numeric, 6);
+                const row_salary: ?pg.Numeric = blk: {
+                    if (salary_numeric) |numeric| {
+                        break :blk pg.Numeric{
+                            .number_of_digits = numeric.number_of_digits,
+                            .weight = numeric.weight,
+                            .sign = numeric.sign,
+                            .scale = numeric.scale,
+                            .digits = try allocator.dupe(u8, numeric.digits),
+                        };
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_salary) |numeric| {
+                    allocator.free(numeric.digits);
+                };
+
+                const maybe_notes = row.get(?[]const u8, 7);
+                const row_notes: ?[]const u8 = blk: {
+                    if (maybe_notes) |field| {
+                        break :blk try allocator.dupe(u8, field);
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_notes) |field| {
+                    allocator.free(field);
+                };
+                const row_created_at = row.get(i64, 8);
+                const row_updated_at = row.get(i64, 9);
+                const row_archived_at = row.get(?i64, 10);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .name = row_name,
+                    .email = row_email,
+                    .password = row_password,
+                    .role = row_role,
+                    .ip_address = row_ip_address,
+                    .salary = row_salary,
+                    .notes = row_notes,
+                    .created_at = row_created_at,
+                    .updated_at = row_updated_at,
+                    .archived_at = row_archived_at,
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getUsers along with the slice
+        // holding them.
+        pub fn freeGetUsers(self: Self, rows: []const models.User) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
+
+// Hooks for a Querier that does not observe its queries.
+pub const NoHooks = struct {};
+
+// Calls the optional callbacks declared by Hooks around a query:
+//
+//   pub fn beforeQuery(name: []const u8, sql: []const u8) void
+//   pub fn afterQuery(name: []const u8, duration_ns: u64, rows: ?usize, err: ?anyerror) void
+//
+// The name is the name of the query method. Rows is the number of rows
+// returned, or null for methods passing rows to a context. Callbacks that are
+// not declared are not called, and their overhead compiles away.
+fn QueryHook(comptime Hooks: type) type {
+    return struct {
+        name: []const u8,
+        start: if (@hasDecl(Hooks, "afterQuery")) i128 else void,
+
+        fn begin(name: []const u8, sql: []const u8) @This() {
+            if (@hasDecl(Hooks, "beforeQuery")) {
+                Hooks.beforeQuery(name, sql);
+            }
+            return .{
+                .name = name,
+                .start = if (@hasDecl(Hooks, "afterQuery")) std.time.nanoTimestamp() else {},
+            };
+        }
+
+        fn end(self: @This(), rows: ?usize, err: ?anyerror) void {
+            if (@hasDecl(Hooks, "afterQuery")) {
+                const duration: u64 = @intCast(@max(0, std.time.nanoTimestamp() - self.start));
+                Hooks.afterQuery(self.name, duration, rows, err);
+            }
+        }
+    };
+}
+
+pub const ConnQuerierInterface = QuerierInterface(*pg.Conn, NoHooks);
+pub const PoolQuerierInterface = QuerierInterface(*pg.Pool, NoHooks);
+
+// A type erased interface over the methods of Querier(T). It can be backed by
+// a Querier or any other type declaring the same methods, such as a mock.
+// Methods taking a context are not part of the interface.
+pub fn QuerierInterface(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        ptr: *anyopaque,
+        vtable: *const VTable,
+
+        pub const VTable = struct {
+            createUser: *const fn (ptr: *anyopaque, create_user_params: Querier(T, Hooks).CreateUserParams) anyerror!void,
+            getUser: *const fn (ptr: *anyopaque, id: i32) anyerror!models.User,
+            getUserEmails: *const fn (ptr: *anyopaque) anyerror![]Querier(T, Hooks).GetUserEmailsRow,
+            getUserIDByEmail: *const fn (ptr: *anyopaque, email: []const u8) anyerror!i32,
+            getUserIDsByIPAddress: *const fn (ptr: *anyopaque, ip_address: []const u8) anyerror![]i32,
+            getUserIDsByRole: *const fn (ptr: *anyopaque, role: models.UserRole) anyerror![]i32,
+            getUserIDsBySalaryRange: *const fn (ptr: *anyopaque, salary_1: f64, salary_2: f64) anyerror![]i32,
+            getUserNames: *const fn (ptr: *anyopaque) anyerror![][]const u8,
+            getUsers: *const fn (ptr: *anyopaque) anyerror![]models.User,
+        };
+
+        // Returns an interface calling the methods of impl.
+        pub fn init(comptime Impl: type, impl: *Impl) Self {
+            return .{ .ptr = impl, .vtable = &VTableFor(Impl).vtable };
+        }
+
+        fn VTableFor(comptime Impl: type) type {
+            return struct {
+                const vtable = VTable{
+                    .createUser = struct {
+                        fn call(ptr: *anyopaque, create_user_params: Querier(T, Hooks).CreateUserParams) anyerror!void {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.createUser(create_user_params);
+                        }
+                    }.call,
+                    .getUser = struct {
+                        fn call(ptr: *anyopaque, id: i32) anyerror!models.User {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUser(id);
+                        }
+                    }.call,
+                    .getUserEmails = struct {
+                        fn call(ptr: *anyopaque) anyerror![]Querier(T, Hooks).GetUserEmailsRow {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserEmails();
+                        }
+                    }.call,
+                    .getUserIDByEmail = struct {
+                        fn call(ptr: *anyopaque, email: []const u8) anyerror!i32 {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserIDByEmail(email);
+                        }
+                    }.call,
+                    .getUserIDsByIPAddress = struct {
+                        fn call(ptr: *anyopaque, ip_address: []const u8) anyerror![]i32 {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserIDsByIPAddress(ip_address);
+                        }
+                    }.call,
+                    .getUserIDsByRole = struct {
+                        fn call(ptr: *anyopaque, role: models.UserRole) anyerror![]i32 {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserIDsByRole(role);
+                        }
+                    }.call,
+                    .getUserIDsBySalaryRange = struct {
+                        fn call(ptr: *anyopaque, salary_1: f64, salary_2: f64) anyerror![]i32 {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserIDsBySalaryRange(salary_1, salary_2);
+                        }
+                    }.call,
+                    .getUserNames = struct {
+                        fn call(ptr: *anyopaque) anyerror![][]const u8 {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserNames();
+                        }
+                    }.call,
+                    .getUsers = struct {
+                        fn call(ptr: *anyopaque) anyerror![]models.User {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUsers();
+                        }
+                    }.call,
+                };
+            };
+        }
+
+        pub fn createUser(self: Self, create_user_params: Querier(T, Hooks).CreateUserParams) anyerror!void {
+            return self.vtable.createUser(self.ptr, create_user_params);
+        }
+
+        pub fn getUser(self: Self, id: i32) anyerror!models.User {
+            return self.vtable.getUser(self.ptr, id);
+        }
+
+        pub fn getUserEmails(self: Self) anyerror![]Querier(T, Hooks).GetUserEmailsRow {
+            return self.vtable.getUserEmails(self.ptr);
+        }
+
+        pub fn getUserIDByEmail(self: Self, email: []const u8) anyerror!i32 {
+            return self.vtable.getUserIDByEmail(self.ptr, email);
+        }
+
+        pub fn getUserIDsByIPAddress(self: Self, ip_address: []const u8) anyerror![]i32 {
+            return self.vtable.getUserIDsByIPAddress(self.ptr, ip_address);
+        }
+
+        pub fn getUserIDsByRole(self: Self, role: models.UserRole) anyerror![]i32 {
+            return self.vtable.getUserIDsByRole(self.ptr, role);
+        }
+
+        pub fn getUserIDsBySalaryRange(self: Self, salary_1: f64, salary_2: f64) anyerror![]i32 {
+            return self.vtable.getUserIDsBySalaryRange(self.ptr, salary_1, salary_2);
+        }
+
+        pub fn getUserNames(self: Self) anyerror![][]const u8 {
+            return self.vtable.getUserNames(self.ptr);
+        }
+
+        pub fn getUsers(self: Self) anyerror![]models.User {
+            return self.vtable.getUsers(self.ptr);
+        }
+    };
+}
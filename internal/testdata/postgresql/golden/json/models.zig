@@ -0,0 +1,280 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+
+pub const BillingInvoiceStatus = enum {
+    @"draft",
+    @"paid",
+};
+
+pub const Product = enum {
+    @"laptop",
+    @"desktop",
+    @"mobile",
+    @"tablet",
+};
+
+pub const UserRole = enum {
+    @"admin",
+    @"user",
+};
+
+pub const ApiKey = struct {
+    __allocator: Allocator,
+
+    id: [16]u8,
+    user_id: i32,
+    secret: []u8,
+    fingerprints: [][]u8,
+    created_at: i64,
+    expires_at: ?i64 = null,
+
+    pub fn deinit(self: *const ApiKey) void {
+        self.__allocator.free(self.id);
+        self.__allocator.free(self.secret);
+        for (self.fingerprints) |item| {
+            self.__allocator.free(item);
+        }
+        self.__allocator.free(self.fingerprints);
+    }
+
+    pub fn jsonStringify(self: @This(), jw: anytype) !void {
+        try jw.beginObject();
+        try jw.objectField("id");
+        try json_format.uuid(jw, self.id);
+        try jw.objectField("userId");
+        try jw.write(self.user_id);
+        try jw.objectField("secret");
+        try json_format.bytes(jw, self.secret);
+        try jw.objectField("fingerprints");
+        try json_format.array(jw, self.fingerprints, json_format.bytes);
+        try jw.objectField("createdAt");
+        try json_format.timestamp(jw, self.created_at);
+        try jw.objectField("expiresAt");
+        if (self.expires_at) |value| {
+            try json_format.timestamp(jw, value);
+        } else {
+            try jw.write(null);
+        }
+        try jw.endObject();
+    }
+};
+
+pub const BillingInvoice = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    user_id: i32,
+    status: BillingInvoiceStatus,
+    amount: pg.Numeric,
+    memo: ?[]const u8 = null,
+
+    pub fn deinit(self: *const BillingInvoice) void {
+        self.__allocator.free(self.amount.digits);
+        if (self.memo) |field| {
+            self.__allocator.free(field);
+        }
+    }
+
+    pub fn jsonStringify(self: @This(), jw: anytype) !void {
+        try jw.beginObject();
+        try jw.objectField("id");
+        try jw.write(self.id);
+        try jw.objectField("userId");
+        try jw.write(self.user_id);
+        try jw.objectField("status");
+        try jw.write(self.status);
+        try jw.objectField("amount");
+        try jw.write(self.amount);
+        try jw.objectField("memo");
+        try jw.write(self.memo);
+        try jw.endObject();
+    }
+};
+
+pub const Order = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    order_date: i64,
+    item_ids: []i32,
+    products: []const Product,
+    item_quantities: []pg.Numeric,
+    shipping_addresses: [][]const u8,
+    ip_addresses: []pg.Cidr,
+    total_amount: pg.Numeric,
+
+    pub fn deinit(self: *const Order) void {
+        self.__allocator.free(self.item_ids);
+        self.__allocator.free(self.products);
+        for (self.item_quantities) |item| {
+            self.__allocator.free(item.digits);
+        }
+        self.__allocator.free(self.item_quantities);
+        for (self.shipping_addresses) |item| {
+            self.__allocator.free(item);
+        }
+        self.__allocator.free(self.shipping_addresses);
+        for (self.ip_addresses) |item| {
+            self.__allocator.free(item.address);
+        }
+        self.__allocator.free(self.ip_addresses);
+        self.__allocator.free(self.total_amount.digits);
+    }
+
+    pub fn jsonStringify(self: @This(), jw: anytype) !void {
+        try jw.beginObject();
+        try jw.objectField("id");
+        try jw.write(self.id);
+        try jw.objectField("orderDate");
+        try json_format.timestamp(jw, self.order_date);
+        try jw.objectField("itemIds");
+        try jw.write(self.item_ids);
+        try jw.objectField("products");
+        try jw.write(self.products);
+        try jw.objectField("itemQuantities");
+        try jw.write(self.item_quantities);
+        try jw.objectField("shippingAddresses");
+        try jw.write(self.shipping_addresses);
+        try jw.objectField("ipAddresses");
+        try jw.write(self.ip_addresses);
+        try jw.objectField("totalAmount");
+        try jw.write(self.total_amount);
+        try jw.endObject();
+    }
+};
+
+pub const User = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    name: []const u8,
+    email: []const u8,
+    password: []const u8,
+    role: UserRole,
+    ip_address: ?pg.Cidr = null,
+    salary: ?pg.Numeric = null,
+    notes: ?[]const u8 = null,
+    created_at: i64,
+    updated_at: i64,
+    archived_at: ?i64 = null,
+
+    pub fn deinit(self: *const User) void {
+        self.__allocator.free(self.name);
+        self.__allocator.free(self.email);
+        self.__allocator.free(self.password);
+        if (self.ip_address) |field| {
+            self.__allocator.free(field.address);
+        }
+        if (self.salary) |field| {
+            self.__allocator.free(field.digits);
+        }
+        if (self.notes) |field| {
+            self.__allocator.free(field);
+        }
+    }
+
+    pub fn jsonStringify(self: @This(), jw: anytype) !void {
+        try jw.beginObject();
+        try jw.objectField("id");
+        try jw.write(self.id);
+        try jw.objectField("name");
+        try jw.write(self.name);
+        try jw.objectField("email");
+        try jw.write(self.email);
+        try jw.objectField("password");
+        try jw.write(self.password);
+        try jw.objectField("role");
+        try jw.write(self.role);
+        try jw.objectField("ipAddress");
+        try jw.write(self.ip_address);
+        try jw.objectField("salary");
+        try jw.write(self.salary);
+        try jw.objectField("notes");
+        try jw.write(self.notes);
+        try jw.objectField("createdAt");
+        try json_format.timestamp(jw, self.created_at);
+        try jw.objectField("updatedAt");
+        try json_format.timestamp(jw, self.updated_at);
+        try jw.objectField("archivedAt");
+        if (self.archived_at) |value| {
+            try json_format.timestamp(jw, value);
+        } else {
+            try jw.write(null);
+        }
+        try jw.endObject();
+    }
+};
+
+// Writes values that std.json cannot write as is in generated jsonStringify
+// methods.
+const json_format = struct {
+    // Writes binary data as a base64 encoded string.
+    fn bytes(jw: anytype, value: []const u8) !void {
+        try jw.beginWriteRaw();
+        try jw.stream.writeByte('"');
+        try std.base64.standard.Encoder.encodeWriter(jw.stream, value);
+        try jw.stream.writeByte('"');
+        jw.endWriteRaw();
+    }
+
+    // Writes a UUID in its canonical form, e.g.
+    // "123e4567-e89b-12d3-a456-426614174000".
+    fn uuid(jw: anytype, value: [16]u8) !void {
+        const hex = "0123456789abcdef";
+        var buf: [36]u8 = undefined;
+        var i: usize = 0;
+        for (value, 0..) |byte, idx| {
+            if (idx == 4 or idx == 6 or idx == 8 or idx == 10) {
+                buf[i] = '-';
+                i += 1;
+            }
+            buf[i] = hex[byte >> 4];
+            buf[i + 1] = hex[byte & 0x0f];
+            i += 2;
+        }
+        try jw.write(buf[0..]);
+    }
+
+    // Writes a timestamp in microseconds since the Unix epoch as an RFC 3339
+    // string in UTC, e.g. "2024-01-02T03:04:05.000006Z". Timestamps before the
+    // epoch are written as numbers.
+    fn timestamp(jw: anytype, value: i64) !void {
+        if (value < 0) {
+            return jw.write(value);
+        }
+        try jw.beginWriteRaw();
+        try jw.stream.writeByte('"');
+        try printTimestamp(jw.stream, @intCast(value));
+        try jw.stream.writeByte('"');
+        jw.endWriteRaw();
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn array(jw: anytype, values: anytype, comptime writeValue: anytype) !void {
+        try jw.beginArray();
+        for (values) |value| {
+            try writeValue(jw, value);
+        }
+        try jw.endArray();
+    }
+};
@@ -0,0 +1,229 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+
+pub const BillingInvoiceStatus = enum {
+    @"draft",
+    @"paid",
+};
+
+pub const Product = enum {
+    @"laptop",
+    @"desktop",
+    @"mobile",
+    @"tablet",
+};
+
+pub const UserRole = enum {
+    @"admin",
+    @"user",
+};
+
+pub const ApiKey = struct {
+    __allocator: Allocator,
+
+    id: [16]u8,
+    user_id: i32,
+    secret: []u8,
+    fingerprints: [][]u8,
+    created_at: i64,
+    expires_at: ?i64 = null,
+
+    pub fn deinit(self: *const ApiKey) void {
+        self.__allocator.free(self.id);
+        self.__allocator.free(self.secret);
+        for (self.fingerprints) |item| {
+            self.__allocator.free(item);
+        }
+        self.__allocator.free(self.fingerprints);
+    }
+
+    // The table the struct is read from and its columns, in the order of the
+    // struct fields.
+    pub const metadata = struct {
+        pub const table_name = "api_keys";
+        pub const schema = "public";
+        pub const columns = [_]ColumnMetadata{
+            .{ .name = "id", .field = "id", .sql_type = "uuid", .nullable = false, .array = false, .primary_key = true },
+            .{ .name = "user_id", .field = "user_id", .sql_type = "int4", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "secret", .field = "secret", .sql_type = "bytea", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "fingerprints", .field = "fingerprints", .sql_type = "bytea", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "created_at", .field = "created_at", .sql_type = "timestamptz", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "expires_at", .field = "expires_at", .sql_type = "timestamptz", .nullable = true, .array = false, .primary_key = false },
+        };
+
+        // Returns the metadata of the column held by a struct field.
+        pub fn column(comptime field: []const u8) ColumnMetadata {
+            return comptime for (columns) |c| {
+                if (std.mem.eql(u8, c.field, field)) break c;
+            } else @compileError("no column is held by field " ++ field);
+        }
+    };
+};
+
+pub const BillingInvoice = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    user_id: i32,
+    status: BillingInvoiceStatus,
+    amount: pg.Numeric,
+    memo: ?[]const u8 = null,
+
+    pub fn deinit(self: *const BillingInvoice) void {
+        self.__allocator.free(self.amount.digits);
+        if (self.memo) |field| {
+            self.__allocator.free(field);
+        }
+    }
+
+    // The table the struct is read from and its columns, in the order of the
+    // struct fields.
+    pub const metadata = struct {
+        pub const table_name = "invoices";
+        pub const schema = "billing";
+        pub const columns = [_]ColumnMetadata{
+            .{ .name = "id", .field = "id", .sql_type = "serial", .nullable = false, .array = false, .primary_key = true },
+            .{ .name = "user_id", .field = "user_id", .sql_type = "int4", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "status", .field = "status", .sql_type = "billing.invoice_status", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "amount", .field = "amount", .sql_type = "numeric", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "memo", .field = "memo", .sql_type = "text", .nullable = true, .array = false, .primary_key = false },
+        };
+
+        // Returns the metadata of the column held by a struct field.
+        pub fn column(comptime field: []const u8) ColumnMetadata {
+            return comptime for (columns) |c| {
+                if (std.mem.eql(u8, c.field, field)) break c;
+            } else @compileError("no column is held by field " ++ field);
+        }
+    };
+};
+
+pub const Order = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    order_date: i64,
+    item_ids: []i32,
+    products: []const Product,
+    item_quantities: []pg.Numeric,
+    shipping_addresses: [][]const u8,
+    ip_addresses: []pg.Cidr,
+    total_amount: pg.Numeric,
+
+    pub fn deinit(self: *const Order) void {
+        self.__allocator.free(self.item_ids);
+        self.__allocator.free(self.products);
+        for (self.item_quantities) |item| {
+            self.__allocator.free(item.digits);
+        }
+        self.__allocator.free(self.item_quantities);
+        for (self.shipping_addresses) |item| {
+            self.__allocator.free(item);
+        }
+        self.__allocator.free(self.shipping_addresses);
+        for (self.ip_addresses) |item| {
+            self.__allocator.free(item.address);
+        }
+        self.__allocator.free(self.ip_addresses);
+        self.__allocator.free(self.total_amount.digits);
+    }
+
+    // The table the struct is read from and its columns, in the order of the
+    // struct fields.
+    pub const metadata = struct {
+        pub const table_name = "orders";
+        pub const schema = "public";
+        pub const columns = [_]ColumnMetadata{
+            .{ .name = "id", .field = "id", .sql_type = "serial", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "order_date", .field = "order_date", .sql_type = "timestamp", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "item_ids", .field = "item_ids", .sql_type = "int4", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "products", .field = "products", .sql_type = "product", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "item_quantities", .field = "item_quantities", .sql_type = "numeric", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "shipping_addresses", .field = "shipping_addresses", .sql_type = "text", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "ip_addresses", .field = "ip_addresses", .sql_type = "inet", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "total_amount", .field = "total_amount", .sql_type = "numeric", .nullable = false, .array = false, .primary_key = false },
+        };
+
+        // Returns the metadata of the column held by a struct field.
+        pub fn column(comptime field: []const u8) ColumnMetadata {
+            return comptime for (columns) |c| {
+                if (std.mem.eql(u8, c.field, field)) break c;
+            } else @compileError("no column is held by field " ++ field);
+        }
+    };
+};
+
+pub const User = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    name: []const u8,
+    email: []const u8,
+    password: []const u8,
+    role: UserRole,
+    ip_address: ?pg.Cidr = null,
+    salary: ?pg.Numeric = null,
+    notes: ?[]const u8 = null,
+    created_at: i64,
+    updated_at: i64,
+    archived_at: ?i64 = null,
+
+    pub fn deinit(self: *const User) void {
+        self.__allocator.free(self.name);
+        self.__allocator.free(self.email);
+        self.__allocator.free(self.password);
+        if (self.ip_address) |field| {
+            self.__allocator.free(field.address);
+        }
+        if (self.salary) |field| {
+            self.__allocator.free(field.digits);
+        }
+        if (self.notes) |field| {
+            self.__allocator.free(field);
+        }
+    }
+
+    // The table the struct is read from and its columns, in the order of the
+    // struct fields.
+    pub const metadata = struct {
+        pub const table_name = "users";
+        pub const schema = "public";
+        pub const columns = [_]ColumnMetadata{
+            .{ .name = "id", .field = "id", .sql_type = "serial", .nullable = false, .array = false, .primary_key = true },
+            .{ .name = "name", .field = "name", .sql_type = "text", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "email", .field = "email", .sql_type = "text", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "password", .field = "password", .sql_type = "text", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "role", .field = "role", .sql_type = "user_role", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "ip_address", .field = "ip_address", .sql_type = "inet", .nullable = true, .array = false, .primary_key = false },
+            .{ .name = "salary", .field = "salary", .sql_type = "numeric", .nullable = true, .array = false, .primary_key = false },
+            .{ .name = "notes", .field = "notes", .sql_type = "text", .nullable = true, .array = false, .primary_key = false },
+            .{ .name = "created_at", .field = "created_at", .sql_type = "timestamp", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "updated_at", .field = "updated_at", .sql_type = "timestamp", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "archived_at", .field = "archived_at", .sql_type = "timestamp", .nullable = true, .array = false, .primary_key = false },
+        };
+
+        // Returns the metadata of the column held by a struct field.
+        pub fn column(comptime field: []const u8) ColumnMetadata {
+            return comptime for (columns) |c| {
+                if (std.mem.eql(u8, c.field, field)) break c;
+            } else @compileError("no column is held by field " ++ field);
+        }
+    };
+};
+
+// Describes a column of the table a model is read from.
+pub const ColumnMetadata = struct {
+    // The name of the column in SQL
+    name: []const u8,
+    // The name of the struct field holding the column
+    field: []const u8,
+    // The SQL type of the column, or of the items of array columns
+    sql_type: []const u8,
+    nullable: bool,
+    array: bool,
+    primary_key: bool,
+};
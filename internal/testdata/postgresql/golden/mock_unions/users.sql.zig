@@ -0,0 +1,900 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(T) {
+            return QuerierInterface(T).init(Self, self);
+        }
+        
+        const create_user_sql = 
+            \\INSERT INTO users (
+            \\    name, 
+            \\    email, 
+            \\    password, 
+            \\    role, 
+            \\    ip_address,
+            \\    salary,
+            \\    created_at,
+            \\    updated_at
+            \\) VALUES (
+            \\    $1, $2, $3, $4, $5, $6, NOW(), NOW()
+            \\)
+        ;
+
+        pub const CreateUserParams = struct {
+            name: []const u8,
+            email: []const u8,
+            password: []const u8,
+            role: models.UserRole,
+            ip_address: ?[]const u8 = null,
+            salary: ?f64 = null,
+        };
+
+        pub const CreateUserResult = union(enum) {
+            ok: void,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .ok => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn createUser(self: Self, create_user_params: CreateUserParams) !CreateUserResult {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            _ = conn.exec(create_user_sql, .{ 
+                create_user_params.name,
+                create_user_params.email,
+                create_user_params.password,
+                create_user_params.role,
+                create_user_params.ip_address,
+                create_user_params.salary,
+            }) catch |err| {
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            return .{ .ok = undefined };
+        }
+
+        const get_user_sql = 
+            \\SELECT id, name, email, password, role, ip_address, salary, notes, created_at, updated_at, archived_at FROM users
+            \\WHERE id = $1 LIMIT 1
+        ;
+
+        pub const GetUserResult = union(enum) {
+            user: models.User,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .user => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getUser(self: Self, id: i32) !GetUserResult {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = conn.query(get_user_sql, .{ 
+                id,
+            }) catch |err| {
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+            const row_name = try allocator.dupe(u8, row.get([]const u8, 1));
+            errdefer allocator.free(row_name);
+            const row_email = try allocator.dupe(u8, row.get([]const u8, 2));
+            errdefer allocator.free(row_email);
+            const row_password = try allocator.dupe(u8, row.get([]const u8, 3));
+            errdefer allocator.free(row_password);
+            const row_role = row.get(models.UserRole, 4);
+            const ip_address_cidr = row.get(?pg.Cidr, 5);
+            const row_ip_address: ?pg.Cidr = blk: {
+                if (ip_address_cidr) |cidr| {
+                    break :blk pg.Cidr{
+                        .address = try allocator.dupe(u8, cidr.address),
+                        .netmask = cidr.netmask,
+                        .family = cidr.family,
+                    };
+                }
+                break :blk null;
+            };
+            errdefer if (row_ip_address) |cidr| {
+                allocator.free(cidr.address);
+            };
+            const salary_numeric = row.get(?pg.Numeric, 6);
+            const row_salary: ?pg.Numeric = blk: {
+                if (salary_numeric) |numeric| {
+                    break :blk pg.Numeric{
+                        .number_of_digits = numeric.number_of_digits,
+                        .weight = numeric.weight,
+                        .sign = numeric.sign,
+                        .scale = numeric.scale,
+                        .digits = try allocator.dupe(u8, numeric.digits),
+                    };
+                }
+                break :blk null;
+            };
+            errdefer if (row_salary) |numeric| {
+                allocator.free(numeric.digits);
+            };
+
+            const maybe_notes = row.get(?[]const u8, 7);
+            const row_notes: ?[]const u8 = blk: {
+                if (maybe_notes) |field| {
+                    break :blk try allocator.dupe(u8, field);
+                }
+                break :blk null;
+            };
+            errdefer if (row_notes) |field| {
+                allocator.free(field);
+            };
+            const row_created_at = row.get(i64, 8);
+            const row_updated_at = row.get(i64, 9);
+            const row_archived_at = row.get(?i64, 10);
+
+            return .{
+                .user = .{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .name = row_name,
+                    .email = row_email,
+                    .password = row_password,
+                    .role = row_role,
+                    .ip_address = row_ip_address,
+                    .salary = row_salary,
+                    .notes = row_notes,
+                    .created_at = row_created_at,
+                    .updated_at = row_updated_at,
+                    .archived_at = row_archived_at,
+                }
+            };
+        }
+
+        const get_user_emails_sql = 
+            \\SELECT id, email FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetUserEmailsRow = struct {
+            __allocator: Allocator,
+
+            id: i32,
+            email: []const u8,
+
+            pub fn deinit(self: *const GetUserEmailsRow) void {
+                self.__allocator.free(self.email);
+            }
+        };
+
+        pub const GetUserEmailsResult = union(enum) {
+            get_user_emails_row_list: []GetUserEmailsRow,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .get_user_emails_row_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getUserEmails(self: Self) !GetUserEmailsResult {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = conn.query(get_user_emails_sql, .{}) catch |err| {
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList(GetUserEmailsRow).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_email = try allocator.dupe(u8, row.get([]const u8, 1));
+                errdefer allocator.free(row_email);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .email = row_email,
+                });
+            }
+
+            return .{
+                .get_user_emails_row_list = try out.toOwnedSlice(),
+            };
+        }
+
+        // Frees the rows returned by getUserEmails along with the slice
+        // holding them.
+        pub fn freeGetUserEmails(self: Self, rows: []const GetUserEmailsRow) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+        const get_user_id_by_email_sql = 
+            \\SELECT id FROM users
+            \\WHERE email = $1 LIMIT 1
+        ;
+
+        pub const GetUserIDByEmailResult = union(enum) {
+            id: i32,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .id => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getUserIDByEmail(self: Self, email: []const u8) !GetUserIDByEmailResult {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = conn.query(get_user_id_by_email_sql, .{ 
+                email,
+            }) catch |err| {
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+
+            return .{ .id = row_id};
+        }
+
+        const get_user_i_ds_by_ip_address_sql = 
+            \\SELECT id FROM users
+            \\WHERE ip_address = $1
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetUserIDsByIPAddressResult = union(enum) {
+            id_list: []i32,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .id_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getUserIDsByIPAddress(self: Self, ip_address: []const u8) !GetUserIDsByIPAddressResult {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = conn.query(get_user_i_ds_by_ip_address_sql, .{ 
+                ip_address,
+            }) catch |err| {
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList(i32).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                try out.append(row_id);
+            }
+
+            return .{
+                .id_list = try out.toOwnedSlice(),
+            };
+        }
+
+        const get_user_i_ds_by_role_sql = 
+            \\SELECT id FROM users
+            \\WHERE role = $1
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetUserIDsByRoleResult = union(enum) {
+            id_list: []i32,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .id_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getUserIDsByRole(self: Self, role: models.UserRole) !GetUserIDsByRoleResult {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = conn.query(get_user_i_ds_by_role_sql, .{ 
+                role,
+            }) catch |err| {
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList(i32).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                try out.append(row_id);
+            }
+
+            return .{
+                .id_list = try out.toOwnedSlice(),
+            };
+        }
+
+        const get_user_i_ds_by_salary_range_sql = 
+            \\SELECT id FROM users
+            \\WHERE salary >= $1 AND salary <= $2
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetUserIDsBySalaryRangeResult = union(enum) {
+            id_list: []i32,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .id_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getUserIDsBySalaryRange(self: Self, salary_1: f64, salary_2: f64) !GetUserIDsBySalaryRangeResult {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = conn.query(get_user_i_ds_by_salary_range_sql, .{ 
+                salary_1,
+                salary_2,
+            }) catch |err| {
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList(i32).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                try out.append(row_id);
+            }
+
+            return .{
+                .id_list = try out.toOwnedSlice(),
+            };
+        }
+
+        const get_user_names_sql = 
+            \\SELECT name FROM users
+        ;
+
+        pub const GetUserNamesResult = union(enum) {
+            name_list: [][]const u8,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .name_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getUserNames(self: Self) !GetUserNamesResult {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = conn.query(get_user_names_sql, .{}) catch |err| {
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList([]const u8).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_name = try allocator.dupe(u8, row.get([]const u8, 0));
+                errdefer allocator.free(row_name);
+                try out.append(row_name);
+            }
+
+            return .{
+                .name_list = try out.toOwnedSlice(),
+            };
+        }
+
+        // Frees the rows returned by getUserNames along with the slice
+        // holding them.
+        pub fn freeGetUserNames(self: Self, rows: []const []const u8) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                allocator.free(row);
+            }
+            allocator.free(rows);
+        }
+
+        const get_users_sql = 
+            \\SELECT id, name, email, password, role, ip_address, salary, notes, created_at, updated_at, archived_at FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetUsersResult = union(enum) {
+            user_list: []models.User,
+            pgerr: []const u8,
+
+            pub fn err(self: @This()) ?pg.Error {
+                switch (self) {
+                    .user_list => return null,
+                    .pgerr => return pg.Error.parse(self.pgerr),
+                }
+            }
+        };
+
+        pub fn getUsers(self: Self) !GetUsersResult {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = conn.query(get_users_sql, .{}) catch |err| {
+                if (conn.err) |_| {
+                    return .{ .pgerr = try allocator.dupe(u8, conn._err_data orelse unreachable) };
+                }
+                return err;
+            };
+            defer result.deinit();
+            var out = std.ArrayList(models.User).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_name = try allocator.dupe(u8, row.get([]const u8, 1));
+                errdefer allocator.free(row_name);
+                const row_email = try allocator.dupe(u8, row.get([]const u8, 2));
+                errdefer allocator.free(row_email);
+                const row_password = try allocator.dupe(u8, row.get([]const u8, 3));
+                errdefer allocator.free(row_password);
+                const row_role = row.get(models.UserRole, 4);
+                const ip_address_cidr = row.get(?pg.Cidr, 5);
+                const row_ip_address: ?pg.Cidr = blk: {
+                    if (ip_address_cidr) |cidr| {
+                        break :blk pg.Cidr{
+                            .address = try allocator.dupe(u8, cidr.address),
+                            .netmask = cidr.netmask,
+                            .family = cidr.family,
+                        };
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_ip_address) |cidr| {
+                    allocator.free(cidr.address);
+                };
+                const salary_numeric = row.get(?pg.Numeric, 6);
+                const row_salary: ?pg.Numeric = blk: {
+                    if (salary_numeric) |numeric| {
+                        break :blk pg.Numeric{
+                            .number_of_digits = numeric.number_of_digits,
+                            .weight = numeric.weight,
+                            .sign = numeric.sign,
+                            .scale = numeric.scale,
+                            .digits = try allocator.dupe(u8, numeric.digits),
+                        };
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_salary) |numeric| {
+                    allocator.free(numeric.digits);
+                };
+
+                const maybe_notes = row.get(?[]const u8, 7);
+                const row_notes: ?[]const u8 = blk: {
+                    if (maybe_notes) |field| {
+                        break :blk try allocator.dupe(u8, field);
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_notes) |field| {
+                    allocator.free(field);
+                };
+                const row_created_at = row.get(i64, 8);
+                const row_updated_at = row.get(i64, 9);
+                const row_archived_at = row.get(?i64, 10);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .name = row_name,
+                    .email = row_email,
+                    .password = row_password,
+                    .role = row_role,
+                    .ip_address = row_ip_address,
+                    .salary = row_salary,
+                    .notes = row_notes,
+                    .created_at = row_created_at,
+                    .updated_at = row_updated_at,
+                    .archived_at = row_archived_at,
+                });
+            }
+
+            return .{
+                .user_list = try out.toOwnedSlice(),
+            };
+        }
+
+        // Frees the rows returned by getUsers along with the slice
+        // holding them.
+        pub fn freeGetUsers(self: Self, rows: []const models.User) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
+
+pub const ConnQuerierInterface = QuerierInterface(*pg.Conn);
+pub const PoolQuerierInterface = QuerierInterface(*pg.Pool);
+
+// A type erased interface over the methods of Querier(T). It can be backed by
+// a Querier or any other type declaring the same methods, such as a mock.
+// Methods taking a context are not part of the interface.
+pub fn QuerierInterface(comptime T: type) type {
+    return struct {
+        const Self = @This();
+
+        ptr: *anyopaque,
+        vtable: *const VTable,
+
+        pub const VTable = struct {
+            createUser: *const fn (ptr: *anyopaque, create_user_params: Querier(T).CreateUserParams) anyerror!Querier(T).CreateUserResult,
+            getUser: *const fn (ptr: *anyopaque, id: i32) anyerror!Querier(T).GetUserResult,
+            getUserEmails: *const fn (ptr: *anyopaque) anyerror!Querier(T).GetUserEmailsResult,
+            getUserIDByEmail: *const fn (ptr: *anyopaque, email: []const u8) anyerror!Querier(T).GetUserIDByEmailResult,
+            getUserIDsByIPAddress: *const fn (ptr: *anyopaque, ip_address: []const u8) anyerror!Querier(T).GetUserIDsByIPAddressResult,
+            getUserIDsByRole: *const fn (ptr: *anyopaque, role: models.UserRole) anyerror!Querier(T).GetUserIDsByRoleResult,
+            getUserIDsBySalaryRange: *const fn (ptr: *anyopaque, salary_1: f64, salary_2: f64) anyerror!Querier(T).GetUserIDsBySalaryRangeResult,
+            getUserNames: *const fn (ptr: *anyopaque) anyerror!Querier(T).GetUserNamesResult,
+            getUsers: *const fn (ptr: *anyopaque) anyerror!Querier(T).GetUsersResult,
+        };
+
+        // Returns an interface calling the methods of impl.
+        pub fn init(comptime Impl: type, impl: *Impl) Self {
+            return .{ .ptr = impl, .vtable = &VTableFor(Impl).vtable };
+        }
+
+        fn VTableFor(comptime Impl: type) type {
+            return struct {
+                const vtable = VTable{
+                    .createUser = struct {
+                        fn call(ptr: *anyopaque, create_user_params: Querier(T).CreateUserParams) anyerror!Querier(T).CreateUserResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.createUser(create_user_params);
+                        }
+                    }.call,
+                    .getUser = struct {
+                        fn call(ptr: *anyopaque, id: i32) anyerror!Querier(T).GetUserResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUser(id);
+                        }
+                    }.call,
+                    .getUserEmails = struct {
+                        fn call(ptr: *anyopaque) anyerror!Querier(T).GetUserEmailsResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserEmails();
+                        }
+                    }.call,
+                    .getUserIDByEmail = struct {
+                        fn call(ptr: *anyopaque, email: []const u8) anyerror!Querier(T).GetUserIDByEmailResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserIDByEmail(email);
+                        }
+                    }.call,
+                    .getUserIDsByIPAddress = struct {
+                        fn call(ptr: *anyopaque, ip_address: []const u8) anyerror!Querier(T).GetUserIDsByIPAddressResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserIDsByIPAddress(ip_address);
+                        }
+                    }.call,
+                    .getUserIDsByRole = struct {
+                        fn call(ptr: *anyopaque, role: models.UserRole) anyerror!Querier(T).GetUserIDsByRoleResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserIDsByRole(role);
+                        }
+                    }.call,
+                    .getUserIDsBySalaryRange = struct {
+                        fn call(ptr: *anyopaque, salary_1: f64, salary_2: f64) anyerror!Querier(T).GetUserIDsBySalaryRangeResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserIDsBySalaryRange(salary_1, salary_2);
+                        }
+                    }.call,
+                    .getUserNames = struct {
+                        fn call(ptr: *anyopaque) anyerror!Querier(T).GetUserNamesResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserNames();
+                        }
+                    }.call,
+                    .getUsers = struct {
+                        fn call(ptr: *anyopaque) anyerror!Querier(T).GetUsersResult {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUsers();
+                        }
+                    }.call,
+                };
+            };
+        }
+
+        pub fn createUser(self: Self, create_user_params: Querier(T).CreateUserParams) anyerror!Querier(T).CreateUserResult {
+            return self.vtable.createUser(self.ptr, create_user_params);
+        }
+
+        pub fn getUser(self: Self, id: i32) anyerror!Querier(T).GetUserResult {
+            return self.vtable.getUser(self.ptr, id);
+        }
+
+        pub fn getUserEmails(self: Self) anyerror!Querier(T).GetUserEmailsResult {
+            return self.vtable.getUserEmails(self.ptr);
+        }
+
+        pub fn getUserIDByEmail(self: Self, email: []const u8) anyerror!Querier(T).GetUserIDByEmailResult {
+            return self.vtable.getUserIDByEmail(self.ptr, email);
+        }
+
+        pub fn getUserIDsByIPAddress(self: Self, ip_address: []const u8) anyerror!Querier(T).GetUserIDsByIPAddressResult {
+            return self.vtable.getUserIDsByIPAddress(self.ptr, ip_address);
+        }
+
+        pub fn getUserIDsByRole(self: Self, role: models.UserRole) anyerror!Querier(T).GetUserIDsByRoleResult {
+            return self.vtable.getUserIDsByRole(self.ptr, role);
+        }
+
+        pub fn getUserIDsBySalaryRange(self: Self, salary_1: f64, salary_2: f64) anyerror!Querier(T).GetUserIDsBySalaryRangeResult {
+            return self.vtable.getUserIDsBySalaryRange(self.ptr, salary_1, salary_2);
+        }
+
+        pub fn getUserNames(self: Self) anyerror!Querier(T).GetUserNamesResult {
+            return self.vtable.getUserNames(self.ptr);
+        }
+
+        pub fn getUsers(self: Self) anyerror!Querier(T).GetUsersResult {
+            return self.vtable.getUsers(self.ptr);
+        }
+    };
+}
+
+pub const ConnMockQuerier = MockQuerier(*pg.Conn);
+pub const PoolMockQuerier = MockQuerier(*pg.Pool);
+
+// Records the calls to a single MockQuerier method and the result to return.
+pub fn MockMethod(comptime Args: type, comptime Result: type) type {
+    return struct {
+        // The value returned by the method. Methods returning void succeed
+        // without a result.
+        result: ?Result = null,
+        // An error returned by the method instead of the result.
+        err: ?anyerror = null,
+        // Computes the result of the method from its arguments. Takes
+        // precedence over result and err when set.
+        handler: ?*const fn (args: Args) anyerror!Result = null,
+        // The number of calls expected by MockQuerier.verify, or null to
+        // accept any number of calls.
+        expected_calls: ?usize = null,
+        // The number of times the method was called.
+        calls: usize = 0,
+        // The arguments of the most recent call.
+        last_args: ?Args = null,
+
+        fn call(self: *@This(), args: Args) anyerror!Result {
+            self.calls += 1;
+            self.last_args = args;
+            if (self.handler) |handler| {
+                return handler(args);
+            }
+            if (self.err) |err| {
+                return err;
+            }
+            if (Result == void) {
+                return;
+            }
+            return self.result orelse error.UnexpectedCall;
+        }
+    };
+}
+
+// A mock implementation of the methods of Querier(T) for unit tests. Canned
+// results and expectations are set per method on the expect field, calls
+// without a result set return error.UnexpectedCall unless the method returns
+// void.
+pub fn MockQuerier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+
+        expect: struct {
+            createUser: MockMethod(struct { create_user_params: Querier(T).CreateUserParams }, Querier(T).CreateUserResult) = .{},
+            getUser: MockMethod(struct { id: i32 }, Querier(T).GetUserResult) = .{},
+            getUserEmails: MockMethod(struct {}, Querier(T).GetUserEmailsResult) = .{},
+            getUserIDByEmail: MockMethod(struct { email: []const u8 }, Querier(T).GetUserIDByEmailResult) = .{},
+            getUserIDsByIPAddress: MockMethod(struct { ip_address: []const u8 }, Querier(T).GetUserIDsByIPAddressResult) = .{},
+            getUserIDsByRole: MockMethod(struct { role: models.UserRole }, Querier(T).GetUserIDsByRoleResult) = .{},
+            getUserIDsBySalaryRange: MockMethod(struct { salary_1: f64, salary_2: f64 }, Querier(T).GetUserIDsBySalaryRangeResult) = .{},
+            getUserNames: MockMethod(struct {}, Querier(T).GetUserNamesResult) = .{},
+            getUsers: MockMethod(struct {}, Querier(T).GetUsersResult) = .{},
+        } = .{},
+
+        pub fn interface(self: *Self) QuerierInterface(T) {
+            return QuerierInterface(T).init(Self, self);
+        }
+
+        // Returns error.UnexpectedCallCount if any method with expected_calls
+        // set was called a different number of times.
+        pub fn verify(self: Self) !void {
+            inline for (std.meta.fields(@TypeOf(self.expect))) |field| {
+                const method = @field(self.expect, field.name);
+                if (method.expected_calls) |expected| {
+                    if (method.calls != expected) {
+                        return error.UnexpectedCallCount;
+                    }
+                }
+            }
+        }
+
+        pub fn createUser(self: *Self, create_user_params: Querier(T).CreateUserParams) anyerror!Querier(T).CreateUserResult {
+            return self.expect.createUser.call(.{ .create_user_params = create_user_params });
+        }
+
+        pub fn getUser(self: *Self, id: i32) anyerror!Querier(T).GetUserResult {
+            return self.expect.getUser.call(.{ .id = id });
+        }
+
+        pub fn getUserEmails(self: *Self) anyerror!Querier(T).GetUserEmailsResult {
+            return self.expect.getUserEmails.call(.{});
+        }
+
+        pub fn getUserIDByEmail(self: *Self, email: []const u8) anyerror!Querier(T).GetUserIDByEmailResult {
+            return self.expect.getUserIDByEmail.call(.{ .email = email });
+        }
+
+        pub fn getUserIDsByIPAddress(self: *Self, ip_address: []const u8) anyerror!Querier(T).GetUserIDsByIPAddressResult {
+            return self.expect.getUserIDsByIPAddress.call(.{ .ip_address = ip_address });
+        }
+
+        pub fn getUserIDsByRole(self: *Self, role: models.UserRole) anyerror!Querier(T).GetUserIDsByRoleResult {
+            return self.expect.getUserIDsByRole.call(.{ .role = role });
+        }
+
+        pub fn getUserIDsBySalaryRange(self: *Self, salary_1: f64, salary_2: f64) anyerror!Querier(T).GetUserIDsBySalaryRangeResult {
+            return self.expect.getUserIDsBySalaryRange.call(.{ .salary_1 = salary_1, .salary_2 = salary_2 });
+        }
+
+        pub fn getUserNames(self: *Self) anyerror!Querier(T).GetUserNamesResult {
+            return self.expect.getUserNames.call(.{});
+        }
+
+        pub fn getUsers(self: *Self) anyerror!Querier(T).GetUsersResult {
+            return self.expect.getUsers.call(.{});
+        }
+    };
+}
@@ -0,0 +1,137 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+
+pub const Product = enum {
+    @"laptop",
+    @"desktop",
+    @"mobile",
+    @"tablet",
+};
+
+pub const UserRole = enum {
+    @"administrator",
+    @"user",
+
+    pub fn fromSql(value: []const u8) ?UserRole {
+        if (std.mem.eql(u8, value, "admin")) return .@"administrator";
+        if (std.mem.eql(u8, value, "user")) return .@"user";
+        return null;
+    }
+
+    pub fn toSql(self: UserRole) []const u8 {
+        return switch (self) {
+            .@"administrator" => "admin",
+            .@"user" => "user",
+        };
+    }
+};
+
+pub const Account = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    name: []const u8,
+    email_address: []const u8,
+    password: []const u8,
+    role: UserRole,
+    ip_address: ?pg.Cidr = null,
+    salary: ?pg.Numeric = null,
+    notes: ?[]const u8 = null,
+    created_at: i64,
+    updated_at: i64,
+    archived_at: ?i64 = null,
+
+    pub fn deinit(self: *const Account) void {
+        self.__allocator.free(self.name);
+        self.__allocator.free(self.email_address);
+        self.__allocator.free(self.password);
+        if (self.ip_address) |field| {
+            self.__allocator.free(field.address);
+        }
+        if (self.salary) |field| {
+            self.__allocator.free(field.digits);
+        }
+        if (self.notes) |field| {
+            self.__allocator.free(field);
+        }
+    }
+};
+
+pub const ApiKey = struct {
+    __allocator: Allocator,
+
+    id: [16]u8,
+    user_id: i32,
+    secret: []u8,
+    fingerprints: [][]u8,
+    created_at: i64,
+    expires_at: ?i64 = null,
+
+    pub fn deinit(self: *const ApiKey) void {
+        self.__allocator.free(self.id);
+        self.__allocator.free(self.secret);
+        for (self.fingerprints) |item| {
+            self.__allocator.free(item);
+        }
+        self.__allocator.free(self.fingerprints);
+    }
+};
+
+pub const Order = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    order_date: i64,
+    item_ids: []i32,
+    products: []const Product,
+    item_quantities: []pg.Numeric,
+    shipping_addresses: [][]const u8,
+    ip_addresses: []pg.Cidr,
+    total_amount: pg.Numeric,
+
+    pub fn deinit(self: *const Order) void {
+        self.__allocator.free(self.item_ids);
+        self.__allocator.free(self.products);
+        for (self.item_quantities) |item| {
+            self.__allocator.free(item.digits);
+        }
+        self.__allocator.free(self.item_quantities);
+        for (self.shipping_addresses) |item| {
+            self.__allocator.free(item);
+        }
+        self.__allocator.free(self.shipping_addresses);
+        for (self.ip_addresses) |item| {
+            self.__allocator.free(item.address);
+        }
+        self.__allocator.free(self.ip_addresses);
+        self.__allocator.free(self.total_amount.digits);
+    }
+};
+
+pub const billing = struct {
+    pub const InvoiceStatus = enum {
+        @"draft",
+        @"paid",
+    };
+
+    pub const Bill = struct {
+        __allocator: Allocator,
+
+        id: i32,
+        user_id: i32,
+        status: billing.InvoiceStatus,
+        amount: pg.Numeric,
+        memo: ?[]const u8 = null,
+
+        pub fn deinit(self: *const Bill) void {
+            self.__allocator.free(self.amount.digits);
+            if (self.memo) |field| {
+                self.__allocator.free(field);
+            }
+        }
+    };
+};
@@ -0,0 +1,122 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+
+pub const BillingInvoiceStatus = enum {
+    @"draft",
+    @"paid",
+};
+
+pub const Product = enum {
+    @"laptop",
+    @"desktop",
+    @"mobile",
+    @"tablet",
+};
+
+pub const UserRole = enum {
+    @"admin",
+    @"user",
+};
+
+pub const ApiKeys = struct {
+    __allocator: Allocator,
+
+    id: [16]u8,
+    userId: i32,
+    secret: []u8,
+    fingerprints: [][]u8,
+    createdAt: i64,
+    expiresAt: ?i64 = null,
+
+    pub fn deinit(self: *const ApiKeys) void {
+        self.__allocator.free(self.id);
+        self.__allocator.free(self.secret);
+        for (self.fingerprints) |item| {
+            self.__allocator.free(item);
+        }
+        self.__allocator.free(self.fingerprints);
+    }
+};
+
+pub const BillingInvoices = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    userId: i32,
+    status: BillingInvoiceStatus,
+    amount: pg.Numeric,
+    memo: ?[]const u8 = null,
+
+    pub fn deinit(self: *const BillingInvoices) void {
+        self.__allocator.free(self.amount.digits);
+        if (self.memo) |field| {
+            self.__allocator.free(field);
+        }
+    }
+};
+
+pub const Orders = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    orderDate: i64,
+    itemIds: []i32,
+    products: []const Product,
+    itemQuantities: []pg.Numeric,
+    shippingAddresses: [][]const u8,
+    ipAddresses: []pg.Cidr,
+    totalAmount: pg.Numeric,
+
+    pub fn deinit(self: *const Orders) void {
+        self.__allocator.free(self.itemIds);
+        self.__allocator.free(self.products);
+        for (self.itemQuantities) |item| {
+            self.__allocator.free(item.digits);
+        }
+        self.__allocator.free(self.itemQuantities);
+        for (self.shippingAddresses) |item| {
+            self.__allocator.free(item);
+        }
+        self.__allocator.free(self.shippingAddresses);
+        for (self.ipAddresses) |item| {
+            self.__allocator.free(item.address);
+        }
+        self.__allocator.free(self.ipAddresses);
+        self.__allocator.free(self.totalAmount.digits);
+    }
+};
+
+pub const Users = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    name: []const u8,
+    email: []const u8,
+    password: []const u8,
+    role: UserRole,
+    ipAddress: ?pg.Cidr = null,
+    salary: ?pg.Numeric = null,
+    notes: ?[]const u8 = null,
+    createdAt: i64,
+    updatedAt: i64,
+    archivedAt: ?i64 = null,
+
+    pub fn deinit(self: *const Users) void {
+        self.__allocator.free(self.name);
+        self.__allocator.free(self.email);
+        self.__allocator.free(self.password);
+        if (self.ipAddress) |field| {
+            self.__allocator.free(field.address);
+        }
+        if (self.salary) |field| {
+            self.__allocator.free(field.digits);
+        }
+        if (self.notes) |field| {
+            self.__allocator.free(field);
+        }
+    }
+};
@@ -0,0 +1,387 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+        
+        pub const create_order_sql = 
+            \\INSERT INTO orders (
+            \\    order_date,
+            \\    item_ids,
+            \\    products,
+            \\    item_quantities,
+            \\    shipping_addresses,
+            \\    ip_addresses,
+            \\    total_amount
+            \\) VALUES (
+            \\    $1,
+            \\    $2,
+            \\    $3,
+            \\    $4,
+            \\    $5,
+            \\    $6,
+            \\    $7
+            \\)
+        ;
+
+        pub const CreateOrderParams = struct {
+            orderDate: i64,
+            itemIds: []i32,
+            products: []const models.Product,
+            itemQuantities: []f64,
+            shippingAddresses: [][]const u8,
+            ipAddresses: [][]const u8,
+            totalAmount: f64,
+        };
+
+        pub fn create_order(self: Self, create_order_params: CreateOrderParams) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            _ = try conn.exec(create_order_sql, .{ 
+                create_order_params.orderDate,
+                create_order_params.itemIds,
+                create_order_params.products,
+                create_order_params.itemQuantities,
+                create_order_params.shippingAddresses,
+                create_order_params.ipAddresses,
+                create_order_params.totalAmount,
+            });
+        }
+
+        pub const get_order_by_id_sql = 
+            \\SELECT id, order_date, item_ids, products, item_quantities, shipping_addresses, ip_addresses, total_amount FROM orders
+            \\WHERE id = $1 LIMIT 1
+        ;
+
+        pub fn get_order_by_id(self: Self, id: i32) !models.Orders {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_order_by_id_sql, .{ 
+                id,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = row.get(i32, 0);
+            const row_orderDate = row.get(i64, 1);
+            var row_itemIds = std.ArrayList(i32).init(allocator);
+            defer row_itemIds.deinit();
+            var row_itemIds_iter = row.get(pg.Iterator(i32), 2);
+            while (row_itemIds_iter.next()) |item| {
+                try row_itemIds.append(item);
+            }
+            var row_products = std.ArrayList(models.Product).init(allocator);
+            defer row_products.deinit();
+            var row_products_iter = row.get(pg.Iterator([]const u8), 3);
+            while (row_products_iter.next()) |item| {
+                try row_products.append(std.meta.stringToEnum(models.Product, item) orelse unreachable);
+            }
+            var row_itemQuantities = std.ArrayList(pg.Numeric).init(allocator);
+            defer row_itemQuantities.deinit();
+            var row_itemQuantities_iter = row.get(pg.Iterator(pg.Numeric), 4);
+            while (row_itemQuantities_iter.next()) |item| {
+                const digits = try allocator.dupe(u8, item.digits);
+                errdefer allocator.free(digits);
+                try row_itemQuantities.append(pg.Numeric{
+                    .number_of_digits = item.number_of_digits,
+                    .weight = item.weight,
+                    .sign = item.sign,
+                    .scale = item.scale,
+                    .digits = digits,
+                });
+            }
+            var row_shippingAddresses = std.ArrayList([]const u8).init(allocator);
+            defer row_shippingAddresses.deinit();
+            var row_shippingAddresses_iter = row.get(pg.Iterator([]const u8), 5);
+            while (row_shippingAddresses_iter.next()) |item| {
+                const value = try allocator.dupe(u8, item);
+                errdefer allocator.free(value);
+                try row_shippingAddresses.append(value);
+            }
+            var row_ipAddresses = std.ArrayList(pg.Cidr).init(allocator);
+            defer row_ipAddresses.deinit();
+            var row_ipAddresses_iter = row.get(pg.Iterator(pg.Cidr), 6);
+            while (row_ipAddresses_iter.next()) |item| {
+                const address = try allocator.dupe(u8, item.address);
+                errdefer allocator.free(address);
+                try row_ipAddresses.append(pg.Cidr{
+                    .address = address,
+                    .netmask = item.netmask,
+                    .family = item.family,
+                });
+            }
+            const totalAmount_numeric = row.get(pg.Numeric, 7);const row_totalAmount = pg.Numeric{
+                .number_of_digits = totalAmount_numeric.number_of_digits,
+                .weight = totalAmount_numeric.weight,
+                .sign = totalAmount_numeric.sign,
+                .scale = totalAmount_numeric.scale,
+                .digits = try allocator.dupe(u8, totalAmount_numeric.digits),
+            };
+            errdefer allocator.free(row_totalAmount.digits);
+
+            return .{
+                .__allocator = allocator,
+                .id = row_id,
+                .orderDate = row_orderDate,
+                .itemIds = try row_itemIds.toOwnedSlice(),
+                .products = try row_products.toOwnedSlice(),
+                .itemQuantities = try row_itemQuantities.toOwnedSlice(),
+                .shippingAddresses = try row_shippingAddresses.toOwnedSlice(),
+                .ipAddresses = try row_ipAddresses.toOwnedSlice(),
+                .totalAmount = row_totalAmount,
+            };
+        }
+
+        pub const get_order_partial_sql = 
+            \\SELECT order_date, total_amount, products FROM orders
+        ;
+
+        pub const GetOrderPartialRow = struct {
+            __allocator: Allocator,
+
+            orderDate: i64,
+            totalAmount: pg.Numeric,
+            products: []const models.Product,
+
+            pub fn deinit(self: *const GetOrderPartialRow) void {
+                self.__allocator.free(self.totalAmount.digits);
+            }
+        };
+
+        pub fn get_order_partial(self: Self) ![]GetOrderPartialRow {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_order_partial_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(GetOrderPartialRow).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_orderDate = row.get(i64, 0);
+                const totalAmount_numeric = row.get(pg.Numeric, 1);const row_totalAmount = pg.Numeric{
+                    .number_of_digits = totalAmount_numeric.number_of_digits,
+                    .weight = totalAmount_numeric.weight,
+                    .sign = totalAmount_numeric.sign,
+                    .scale = totalAmount_numeric.scale,
+                    .digits = try allocator.dupe(u8, totalAmount_numeric.digits),
+                };
+                errdefer allocator.free(row_totalAmount.digits);
+                var row_products = std.ArrayList(models.Product).init(allocator);
+                defer row_products.deinit();
+                var row_products_iter = row.get(pg.Iterator([]const u8), 2);
+                while (row_products_iter.next()) |item| {
+                    try row_products.append(std.meta.stringToEnum(models.Product, item) orelse unreachable);
+                }
+                try out.append(.{
+                    .__allocator = allocator,
+                    .orderDate = row_orderDate,
+                    .totalAmount = row_totalAmount,
+                    .products = try row_products.toOwnedSlice(),
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by get_order_partial along with the slice
+        // holding them.
+        pub fn free_get_order_partial(self: Self, rows: []const GetOrderPartialRow) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+        pub const get_order_totals_sql = 
+            \\SELECT total_amount FROM orders
+        ;
+
+        pub fn get_order_totals(self: Self) ![]pg.Numeric {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_order_totals_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(pg.Numeric).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const totalAmount_numeric = row.get(pg.Numeric, 0);const row_totalAmount = pg.Numeric{
+                    .number_of_digits = totalAmount_numeric.number_of_digits,
+                    .weight = totalAmount_numeric.weight,
+                    .sign = totalAmount_numeric.sign,
+                    .scale = totalAmount_numeric.scale,
+                    .digits = try allocator.dupe(u8, totalAmount_numeric.digits),
+                };
+                errdefer allocator.free(row_totalAmount.digits);
+                try out.append(row_totalAmount);
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by get_order_totals along with the slice
+        // holding them.
+        pub fn free_get_order_totals(self: Self, rows: []const pg.Numeric) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                allocator.free(row.digits);
+            }
+            allocator.free(rows);
+        }
+
+        pub const get_orders_sql = 
+            \\SELECT id, order_date, item_ids, products, item_quantities, shipping_addresses, ip_addresses, total_amount FROM orders
+            \\ORDER BY id ASC
+        ;
+
+        pub fn get_orders(self: Self) ![]models.Orders {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_orders_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(models.Orders).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_orderDate = row.get(i64, 1);
+                var row_itemIds = std.ArrayList(i32).init(allocator);
+                defer row_itemIds.deinit();
+                var row_itemIds_iter = row.get(pg.Iterator(i32), 2);
+                while (row_itemIds_iter.next()) |item| {
+                    try row_itemIds.append(item);
+                }
+                var row_products = std.ArrayList(models.Product).init(allocator);
+                defer row_products.deinit();
+                var row_products_iter = row.get(pg.Iterator([]const u8), 3);
+                while (row_products_iter.next()) |item| {
+                    try row_products.append(std.meta.stringToEnum(models.Product, item) orelse unreachable);
+                }
+                var row_itemQuantities = std.ArrayList(pg.Numeric).init(allocator);
+                defer row_itemQuantities.deinit();
+                var row_itemQuantities_iter = row.get(pg.Iterator(pg.Numeric), 4);
+                while (row_itemQuantities_iter.next()) |item| {
+                    const digits = try allocator.dupe(u8, item.digits);
+                    errdefer allocator.free(digits);
+                    try row_itemQuantities.append(pg.Numeric{
+                        .number_of_digits = item.number_of_digits,
+                        .weight = item.weight,
+                        .sign = item.sign,
+                        .scale = item.scale,
+                        .digits = digits,
+                    });
+                }
+                var row_shippingAddresses = std.ArrayList([]const u8).init(allocator);
+                defer row_shippingAddresses.deinit();
+                var row_shippingAddresses_iter = row.get(pg.Iterator([]const u8), 5);
+                while (row_shippingAddresses_iter.next()) |item| {
+                    const value = try allocator.dupe(u8, item);
+                    errdefer allocator.free(value);
+                    try row_shippingAddresses.append(value);
+                }
+                var row_ipAddresses = std.ArrayList(pg.Cidr).init(allocator);
+                defer row_ipAddresses.deinit();
+                var row_ipAddresses_iter = row.get(pg.Iterator(pg.Cidr), 6);
+                while (row_ipAddresses_iter.next()) |item| {
+                    const address = try allocator.dupe(u8, item.address);
+                    errdefer allocator.free(address);
+                    try row_ipAddresses.append(pg.Cidr{
+                        .address = address,
+                        .netmask = item.netmask,
+                        .family = item.family,
+                    });
+                }
+                const totalAmount_numeric = row.get(pg.Numeric, 7);const row_totalAmount = pg.Numeric{
+                    .number_of_digits = totalAmount_numeric.number_of_digits,
+                    .weight = totalAmount_numeric.weight,
+                    .sign = totalAmount_numeric.sign,
+                    .scale = totalAmount_numeric.scale,
+                    .digits = try allocator.dupe(u8, totalAmount_numeric.digits),
+                };
+                errdefer allocator.free(row_totalAmount.digits);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .orderDate = row_orderDate,
+                    .itemIds = try row_itemIds.toOwnedSlice(),
+                    .products = try row_products.toOwnedSlice(),
+                    .itemQuantities = try row_itemQuantities.toOwnedSlice(),
+                    .shippingAddresses = try row_shippingAddresses.toOwnedSlice(),
+                    .ipAddresses = try row_ipAddresses.toOwnedSlice(),
+                    .totalAmount = row_totalAmount,
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by get_orders along with the slice
+        // holding them.
+        pub fn free_get_orders(self: Self, rows: []const models.Orders) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
@@ -0,0 +1,48 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+pub const ApiKey = struct {
+    __allocator: Allocator,
+
+    id: [16]u8,
+    user_id: i32,
+    secret: []u8,
+    fingerprints: [][]u8,
+    created_at: i64,
+    expires_at: ?i64 = null,
+
+    pub fn deinit(self: *const ApiKey) void {
+        self.__allocator.free(self.id);
+        self.__allocator.free(self.secret);
+        for (self.fingerprints) |item| {
+            self.__allocator.free(item);
+        }
+        self.__allocator.free(self.fingerprints);
+    }
+
+    // The table the struct is read from and its columns, in the order of the
+    // struct fields.
+    pub const metadata = struct {
+        pub const table_name = "api_keys";
+        pub const schema = "public";
+        pub const columns = [_]ColumnMetadata{
+            .{ .name = "id", .field = "id", .sql_type = "uuid", .nullable = false, .array = false, .primary_key = true },
+            .{ .name = "user_id", .field = "user_id", .sql_type = "int4", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "secret", .field = "secret", .sql_type = "bytea", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "fingerprints", .field = "fingerprints", .sql_type = "bytea", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "created_at", .field = "created_at", .sql_type = "timestamptz", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "expires_at", .field = "expires_at", .sql_type = "timestamptz", .nullable = true, .array = false, .primary_key = false },
+        };
+
+        // Returns the metadata of the column held by a struct field.
+        pub fn column(comptime field: []const u8) ColumnMetadata {
+            return comptime for (columns) |c| {
+                if (std.mem.eql(u8, c.field, field)) break c;
+            } else @compileError("no column is held by field " ++ field);
+        }
+    };
+};
+
+const ColumnMetadata = @import("models.zig").ColumnMetadata;
@@ -0,0 +1,49 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+
+const enums = @import("enums.zig");
+const billing = enums.billing;
+
+pub const Invoice = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    user_id: i32,
+    status: billing.InvoiceStatus,
+    amount: pg.Numeric,
+    memo: ?[]const u8 = null,
+
+    pub fn deinit(self: *const Invoice) void {
+        self.__allocator.free(self.amount.digits);
+        if (self.memo) |field| {
+            self.__allocator.free(field);
+        }
+    }
+
+    // The table the struct is read from and its columns, in the order of the
+    // struct fields.
+    pub const metadata = struct {
+        pub const table_name = "invoices";
+        pub const schema = "billing";
+        pub const columns = [_]ColumnMetadata{
+            .{ .name = "id", .field = "id", .sql_type = "serial", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "user_id", .field = "user_id", .sql_type = "int4", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "status", .field = "status", .sql_type = "billing.invoice_status", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "amount", .field = "amount", .sql_type = "numeric", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "memo", .field = "memo", .sql_type = "text", .nullable = true, .array = false, .primary_key = false },
+        };
+
+        // Returns the metadata of the column held by a struct field.
+        pub fn column(comptime field: []const u8) ColumnMetadata {
+            return comptime for (columns) |c| {
+                if (std.mem.eql(u8, c.field, field)) break c;
+            } else @compileError("no column is held by field " ++ field);
+        }
+    };
+};
+
+const ColumnMetadata = @import("models.zig").ColumnMetadata;
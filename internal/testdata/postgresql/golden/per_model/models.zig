@@ -0,0 +1,27 @@
+// Generated with sqlc v1.28.0
+
+const enums = @import("enums.zig");
+
+pub const Product = enums.Product;
+pub const UserRole = enums.UserRole;
+pub const ApiKey = @import("api_key.model.zig").ApiKey;
+pub const Order = @import("order.model.zig").Order;
+pub const User = @import("user.model.zig").User;
+
+pub const billing = struct {
+    pub const InvoiceStatus = enums.billing.InvoiceStatus;
+    pub const Invoice = @import("billing.invoice.model.zig").Invoice;
+};
+
+// Describes a column of the table a model is read from.
+pub const ColumnMetadata = struct {
+    // The name of the column in SQL
+    name: []const u8,
+    // The name of the struct field holding the column
+    field: []const u8,
+    // The SQL type of the column, or of the items of array columns
+    sql_type: []const u8,
+    nullable: bool,
+    array: bool,
+    primary_key: bool,
+};
@@ -0,0 +1,66 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+
+const enums = @import("enums.zig");
+const Product = enums.Product;
+
+pub const Order = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    order_date: i64,
+    item_ids: []i32,
+    products: []const Product,
+    item_quantities: []pg.Numeric,
+    shipping_addresses: [][]const u8,
+    ip_addresses: []pg.Cidr,
+    total_amount: pg.Numeric,
+
+    pub fn deinit(self: *const Order) void {
+        self.__allocator.free(self.item_ids);
+        self.__allocator.free(self.products);
+        for (self.item_quantities) |item| {
+            self.__allocator.free(item.digits);
+        }
+        self.__allocator.free(self.item_quantities);
+        for (self.shipping_addresses) |item| {
+            self.__allocator.free(item);
+        }
+        self.__allocator.free(self.shipping_addresses);
+        for (self.ip_addresses) |item| {
+            self.__allocator.free(item.address);
+        }
+        self.__allocator.free(self.ip_addresses);
+        self.__allocator.free(self.total_amount.digits);
+    }
+
+    // The table the struct is read from and its columns, in the order of the
+    // struct fields.
+    pub const metadata = struct {
+        pub const table_name = "orders";
+        pub const schema = "public";
+        pub const columns = [_]ColumnMetadata{
+            .{ .name = "id", .field = "id", .sql_type = "serial", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "order_date", .field = "order_date", .sql_type = "timestamp", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "item_ids", .field = "item_ids", .sql_type = "int4", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "products", .field = "products", .sql_type = "product", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "item_quantities", .field = "item_quantities", .sql_type = "numeric", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "shipping_addresses", .field = "shipping_addresses", .sql_type = "text", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "ip_addresses", .field = "ip_addresses", .sql_type = "inet", .nullable = false, .array = true, .primary_key = false },
+            .{ .name = "total_amount", .field = "total_amount", .sql_type = "numeric", .nullable = false, .array = false, .primary_key = false },
+        };
+
+        // Returns the metadata of the column held by a struct field.
+        pub fn column(comptime field: []const u8) ColumnMetadata {
+            return comptime for (columns) |c| {
+                if (std.mem.eql(u8, c.field, field)) break c;
+            } else @compileError("no column is held by field " ++ field);
+        }
+    };
+};
+
+const ColumnMetadata = @import("models.zig").ColumnMetadata;
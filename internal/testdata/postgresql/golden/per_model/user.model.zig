@@ -0,0 +1,69 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+
+const enums = @import("enums.zig");
+const UserRole = enums.UserRole;
+
+pub const User = struct {
+    __allocator: Allocator,
+
+    id: i32,
+    name: []const u8,
+    email: []const u8,
+    password: []const u8,
+    role: UserRole,
+    ip_address: ?pg.Cidr = null,
+    salary: ?pg.Numeric = null,
+    notes: ?[]const u8 = null,
+    created_at: i64,
+    updated_at: i64,
+    archived_at: ?i64 = null,
+
+    pub fn deinit(self: *const User) void {
+        self.__allocator.free(self.name);
+        self.__allocator.free(self.email);
+        self.__allocator.free(self.password);
+        if (self.ip_address) |field| {
+            self.__allocator.free(field.address);
+        }
+        if (self.salary) |field| {
+            self.__allocator.free(field.digits);
+        }
+        if (self.notes) |field| {
+            self.__allocator.free(field);
+        }
+    }
+
+    // The table the struct is read from and its columns, in the order of the
+    // struct fields.
+    pub const metadata = struct {
+        pub const table_name = "users";
+        pub const schema = "public";
+        pub const columns = [_]ColumnMetadata{
+            .{ .name = "id", .field = "id", .sql_type = "serial", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "name", .field = "name", .sql_type = "text", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "email", .field = "email", .sql_type = "text", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "password", .field = "password", .sql_type = "text", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "role", .field = "role", .sql_type = "user_role", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "ip_address", .field = "ip_address", .sql_type = "inet", .nullable = true, .array = false, .primary_key = false },
+            .{ .name = "salary", .field = "salary", .sql_type = "numeric", .nullable = true, .array = false, .primary_key = false },
+            .{ .name = "notes", .field = "notes", .sql_type = "text", .nullable = true, .array = false, .primary_key = false },
+            .{ .name = "created_at", .field = "created_at", .sql_type = "timestamp", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "updated_at", .field = "updated_at", .sql_type = "timestamp", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "archived_at", .field = "archived_at", .sql_type = "timestamp", .nullable = true, .array = false, .primary_key = false },
+        };
+
+        // Returns the metadata of the column held by a struct field.
+        pub fn column(comptime field: []const u8) ColumnMetadata {
+            return comptime for (columns) |c| {
+                if (std.mem.eql(u8, c.field, field)) break c;
+            } else @compileError("no column is held by field " ++ field);
+        }
+    };
+};
+
+const ColumnMetadata = @import("models.zig").ColumnMetadata;
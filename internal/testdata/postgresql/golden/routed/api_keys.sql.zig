@@ -0,0 +1,256 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(T) {
+            return QuerierInterface(T).init(Self, self);
+        }
+        
+        const get_api_key_sql = 
+            \\SELECT id, user_id, secret, fingerprints, created_at, expires_at FROM api_keys
+            \\WHERE id = $1
+        ;
+
+        pub fn getAPIKey(self: Self, id: [16]u8) !models.ApiKey {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_api_key_sql, .{ 
+                id,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_id = try allocator.dupe([16]u8, row.get([16]u8, 0));
+            errdefer allocator.free(row_id);
+            const row_user_id = row.get(i32, 1);
+            const row_secret = try allocator.dupe(u8, row.get([]u8, 2));
+            errdefer allocator.free(row_secret);
+            var row_fingerprints = std.ArrayList([]u8).init(allocator);
+            defer row_fingerprints.deinit();
+            var row_fingerprints_iter = row.get(pg.Iterator([]u8), 3);
+            while (row_fingerprints_iter.next()) |item| {
+                const value = try allocator.dupe(u8, item);
+                errdefer allocator.free(value);
+                try row_fingerprints.append(value);
+            }
+            const row_created_at = row.get(i64, 4);
+            const row_expires_at = row.get(?i64, 5);
+
+            return .{
+                .__allocator = allocator,
+                .id = row_id,
+                .user_id = row_user_id,
+                .secret = row_secret,
+                .fingerprints = try row_fingerprints.toOwnedSlice(),
+                .created_at = row_created_at,
+                .expires_at = row_expires_at,
+            };
+        }
+
+        const list_api_key_secrets_sql = 
+            \\SELECT id, secret, expires_at FROM api_keys
+            \\WHERE user_id = $1
+            \\ORDER BY created_at ASC
+        ;
+
+        pub const ListAPIKeySecretsRow = struct {
+            __allocator: Allocator,
+
+            id: [16]u8,
+            secret: []u8,
+            expires_at: ?i64 = null,
+
+            pub fn deinit(self: *const ListAPIKeySecretsRow) void {
+                self.__allocator.free(self.id);
+                self.__allocator.free(self.secret);
+            }
+        };
+
+        pub fn listAPIKeySecrets(self: Self, user_id: i32) ![]ListAPIKeySecretsRow {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(list_api_key_secrets_sql, .{ 
+                user_id,
+            });
+            defer result.deinit();
+            var out = std.ArrayList(ListAPIKeySecretsRow).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = try allocator.dupe([16]u8, row.get([16]u8, 0));
+                errdefer allocator.free(row_id);
+                const row_secret = try allocator.dupe(u8, row.get([]u8, 1));
+                errdefer allocator.free(row_secret);
+                const row_expires_at = row.get(?i64, 2);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .secret = row_secret,
+                    .expires_at = row_expires_at,
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by listAPIKeySecrets along with the slice
+        // holding them.
+        pub fn freeListAPIKeySecrets(self: Self, rows: []const ListAPIKeySecretsRow) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
+
+// Routes queries between a primary and a replica pool. Queries returning rows
+// from a SELECT statement run on the replica, all other queries run on the
+// primary. Queries in a transaction always run on the primary.
+pub const RoutedQuerier = struct {
+    const Self = @This();
+    const Pool = Querier(*pg.Pool);
+    const Conn = Querier(*pg.Conn);
+
+    primary: Pool,
+    replica: Pool,
+
+    pub fn init(allocator: Allocator, primary: *pg.Pool, replica: *pg.Pool) Self {
+        return .{
+            .primary = Pool.init(allocator, primary),
+            .replica = Pool.init(allocator, replica),
+        };
+    }
+
+    pub fn interface(self: *Self) QuerierInterface(*pg.Pool) {
+        return QuerierInterface(*pg.Pool).init(Self, self);
+    }
+
+    // Begins a transaction on a connection acquired from the primary pool. The
+    // connection is released by commit or rollback.
+    pub fn begin(self: Self) !Transaction {
+        const conn = try self.primary.conn.acquire();
+        errdefer self.primary.conn.release(conn);
+        try conn.begin();
+        return .{
+            .querier = Conn.init(self.primary.allocator, conn),
+            .pool = self.primary.conn,
+        };
+    }
+
+    pub const Transaction = struct {
+        querier: Conn,
+        pool: *pg.Pool,
+
+        pub fn commit(self: Transaction) !void {
+            defer self.pool.release(self.querier.conn);
+            try self.querier.conn.commit();
+        }
+
+        pub fn rollback(self: Transaction) !void {
+            defer self.pool.release(self.querier.conn);
+            try self.querier.conn.rollback();
+        }
+    };
+
+    pub fn getAPIKey(self: Self, id: [16]u8) !models.ApiKey {
+        return self.replica.getAPIKey(id);
+    }
+
+    pub fn listAPIKeySecrets(self: Self, user_id: i32) ![]Pool.ListAPIKeySecretsRow {
+        return self.replica.listAPIKeySecrets(user_id);
+    }
+
+    pub fn freeListAPIKeySecrets(self: Self, rows: []const Pool.ListAPIKeySecretsRow) void {
+        self.primary.freeListAPIKeySecrets(rows);
+    }
+};
+
+pub const ConnQuerierInterface = QuerierInterface(*pg.Conn);
+pub const PoolQuerierInterface = QuerierInterface(*pg.Pool);
+
+// A type erased interface over the methods of Querier(T). It can be backed by
+// a Querier or any other type declaring the same methods, such as a mock.
+// Methods taking a context are not part of the interface.
+pub fn QuerierInterface(comptime T: type) type {
+    return struct {
+        const Self = @This();
+
+        ptr: *anyopaque,
+        vtable: *const VTable,
+
+        pub const VTable = struct {
+            getAPIKey: *const fn (ptr: *anyopaque, id: [16]u8) anyerror!models.ApiKey,
+            listAPIKeySecrets: *const fn (ptr: *anyopaque, user_id: i32) anyerror![]Querier(T).ListAPIKeySecretsRow,
+        };
+
+        // Returns an interface calling the methods of impl.
+        pub fn init(comptime Impl: type, impl: *Impl) Self {
+            return .{ .ptr = impl, .vtable = &VTableFor(Impl).vtable };
+        }
+
+        fn VTableFor(comptime Impl: type) type {
+            return struct {
+                const vtable = VTable{
+                    .getAPIKey = struct {
+                        fn call(ptr: *anyopaque, id: [16]u8) anyerror!models.ApiKey {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getAPIKey(id);
+                        }
+                    }.call,
+                    .listAPIKeySecrets = struct {
+                        fn call(ptr: *anyopaque, user_id: i32) anyerror![]Querier(T).ListAPIKeySecretsRow {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.listAPIKeySecrets(user_id);
+                        }
+                    }.call,
+                };
+            };
+        }
+
+        pub fn getAPIKey(self: Self, id: [16]u8) anyerror!models.ApiKey {
+            return self.vtable.getAPIKey(self.ptr, id);
+        }
+
+        pub fn listAPIKeySecrets(self: Self, user_id: i32) anyerror![]Querier(T).ListAPIKeySecretsRow {
+            return self.vtable.listAPIKeySecrets(self.ptr, user_id);
+        }
+    };
+}
@@ -0,0 +1,281 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(*pg.Conn);
+pub const PoolQuerier = Querier(*pg.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(T) {
+            return QuerierInterface(T).init(Self, self);
+        }
+        
+        const create_invoice_sql = 
+            \\INSERT INTO billing.invoices (user_id, status, amount, memo) VALUES ($1, $2, $3, $4)
+        ;
+
+        pub const CreateInvoiceParams = struct {
+            user_id: i32,
+            status: models.BillingInvoiceStatus,
+            amount: f64,
+            memo: ?[]const u8 = null,
+        };
+
+        pub fn createInvoice(self: Self, create_invoice_params: CreateInvoiceParams) !void {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            _ = try conn.exec(create_invoice_sql, .{ 
+                create_invoice_params.user_id,
+                create_invoice_params.status,
+                create_invoice_params.amount,
+                create_invoice_params.memo,
+            });
+        }
+
+        const get_invoice_status_sql = 
+            \\SELECT status FROM billing.invoices
+            \\WHERE id = $1
+        ;
+
+        pub fn getInvoiceStatus(self: Self, id: i32) !models.BillingInvoiceStatus {
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_invoice_status_sql, .{ 
+                id,
+            });
+            defer result.deinit();
+            const row = try result.next() orelse return error.NotFound;
+
+            const row_status = row.get(models.BillingInvoiceStatus, 0);
+
+            return row_status;
+        }
+
+        const get_invoices_sql = 
+            \\SELECT id, user_id, status, amount, memo FROM billing.invoices
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getInvoices(self: Self) ![]models.BillingInvoice {
+            const allocator = self.allocator;
+            var conn: *pg.Conn = blk: {
+                if (T == *pg.Pool) {
+                    break :blk try self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *pg.Pool) {
+                self.conn.release(conn);
+            };
+            const result = try conn.query(get_invoices_sql, .{});
+            defer result.deinit();
+            var out = std.ArrayList(models.BillingInvoice).init(allocator);
+            defer out.deinit();
+            while (try result.next()) |row| {
+                const row_id = row.get(i32, 0);
+                const row_user_id = row.get(i32, 1);
+                const row_status = row.get(models.BillingInvoiceStatus, 2);
+                const amount_numeric = row.get(pg.Numeric, 3);const row_amount = pg.Numeric{
+                    .number_of_digits = amount_numeric.number_of_digits,
+                    .weight = amount_numeric.weight,
+                    .sign = amount_numeric.sign,
+                    .scale = amount_numeric.scale,
+                    .digits = try allocator.dupe(u8, amount_numeric.digits),
+                };
+                errdefer allocator.free(row_amount.digits);
+
+                const maybe_memo = row.get(?[]const u8, 4);
+                const row_memo: ?[]const u8 = blk: {
+                    if (maybe_memo) |field| {
+                        break :blk try allocator.dupe(u8, field);
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_memo) |field| {
+                    allocator.free(field);
+                };
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .user_id = row_user_id,
+                    .status = row_status,
+                    .amount = row_amount,
+                    .memo = row_memo,
+                });
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getInvoices along with the slice
+        // holding them.
+        pub fn freeGetInvoices(self: Self, rows: []const models.BillingInvoice) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
+
+// Routes queries between a primary and a replica pool. Queries returning rows
+// from a SELECT statement run on the replica, all other queries run on the
+// primary. Queries in a transaction always run on the primary.
+pub const RoutedQuerier = struct {
+    const Self = @This();
+    const Pool = Querier(*pg.Pool);
+    const Conn = Querier(*pg.Conn);
+
+    primary: Pool,
+    replica: Pool,
+
+    pub fn init(allocator: Allocator, primary: *pg.Pool, replica: *pg.Pool) Self {
+        return .{
+            .primary = Pool.init(allocator, primary),
+            .replica = Pool.init(allocator, replica),
+        };
+    }
+
+    pub fn interface(self: *Self) QuerierInterface(*pg.Pool) {
+        return QuerierInterface(*pg.Pool).init(Self, self);
+    }
+
+    // Begins a transaction on a connection acquired from the primary pool. The
+    // connection is released by commit or rollback.
+    pub fn begin(self: Self) !Transaction {
+        const conn = try self.primary.conn.acquire();
+        errdefer self.primary.conn.release(conn);
+        try conn.begin();
+        return .{
+            .querier = Conn.init(self.primary.allocator, conn),
+            .pool = self.primary.conn,
+        };
+    }
+
+    pub const Transaction = struct {
+        querier: Conn,
+        pool: *pg.Pool,
+
+        pub fn commit(self: Transaction) !void {
+            defer self.pool.release(self.querier.conn);
+            try self.querier.conn.commit();
+        }
+
+        pub fn rollback(self: Transaction) !void {
+            defer self.pool.release(self.querier.conn);
+            try self.querier.conn.rollback();
+        }
+    };
+
+    pub fn createInvoice(self: Self, create_invoice_params: Pool.CreateInvoiceParams) !void {
+        return self.primary.createInvoice(create_invoice_params);
+    }
+
+    pub fn getInvoiceStatus(self: Self, id: i32) !models.BillingInvoiceStatus {
+        return self.replica.getInvoiceStatus(id);
+    }
+
+    pub fn getInvoices(self: Self) ![]models.BillingInvoice {
+        return self.replica.getInvoices();
+    }
+
+    pub fn freeGetInvoices(self: Self, rows: []const models.BillingInvoice) void {
+        self.primary.freeGetInvoices(rows);
+    }
+};
+
+pub const ConnQuerierInterface = QuerierInterface(*pg.Conn);
+pub const PoolQuerierInterface = QuerierInterface(*pg.Pool);
+
+// A type erased interface over the methods of Querier(T). It can be backed by
+// a Querier or any other type declaring the same methods, such as a mock.
+// Methods taking a context are not part of the interface.
+pub fn QuerierInterface(comptime T: type) type {
+    return struct {
+        const Self = @This();
+
+        ptr: *anyopaque,
+        vtable: *const VTable,
+
+        pub const VTable = struct {
+            createInvoice: *const fn (ptr: *anyopaque, create_invoice_params: Querier(T).CreateInvoiceParams) anyerror!void,
+            getInvoiceStatus: *const fn (ptr: *anyopaque, id: i32) anyerror!models.BillingInvoiceStatus,
+            getInvoices: *const fn (ptr: *anyopaque) anyerror![]models.BillingInvoice,
+        };
+
+        // Returns an interface calling the methods of impl.
+        pub fn init(comptime Impl: type, impl: *Impl) Self {
+            return .{ .ptr = impl, .vtable = &VTableFor(Impl).vtable };
+        }
+
+        fn VTableFor(comptime Impl: type) type {
+            return struct {
+                const vtable = VTable{
+                    .createInvoice = struct {
+                        fn call(ptr: *anyopaque, create_invoice_params: Querier(T).CreateInvoiceParams) anyerror!void {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.createInvoice(create_invoice_params);
+                        }
+                    }.call,
+                    .getInvoiceStatus = struct {
+                        fn call(ptr: *anyopaque, id: i32) anyerror!models.BillingInvoiceStatus {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getInvoiceStatus(id);
+                        }
+                    }.call,
+                    .getInvoices = struct {
+                        fn call(ptr: *anyopaque) anyerror![]models.BillingInvoice {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getInvoices();
+                        }
+                    }.call,
+                };
+            };
+        }
+
+        pub fn createInvoice(self: Self, create_invoice_params: Querier(T).CreateInvoiceParams) anyerror!void {
+            return self.vtable.createInvoice(self.ptr, create_invoice_params);
+        }
+
+        pub fn getInvoiceStatus(self: Self, id: i32) anyerror!models.BillingInvoiceStatus {
+            return self.vtable.getInvoiceStatus(self.ptr, id);
+        }
+
+        pub fn getInvoices(self: Self) anyerror![]models.BillingInvoice {
+            return self.vtable.getInvoices(self.ptr);
+        }
+    };
+}
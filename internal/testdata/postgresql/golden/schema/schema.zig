@@ -0,0 +1,54 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+
+const pg = @import("pg");
+
+pub const File = struct {
+    // The path of the file relative to the sqlc configuration
+    name: []const u8,
+    sql: []const u8,
+};
+
+// The schema files read by sqlc, in the order they are applied.
+pub const files = [_]File{
+    .{ .name = "schema.sql", .sql = @embedFile("schema.sql") },
+};
+
+const version_table_sql = "CREATE TABLE IF NOT EXISTS sqlc_schema_version (checksum TEXT NOT NULL, applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW())";
+
+// Returns the hex encoded SHA-256 checksum of the schema files.
+pub fn checksum() [64]u8 {
+    var hasher = std.crypto.hash.sha2.Sha256.init(.{});
+    for (files) |file| {
+        hasher.update(file.sql);
+    }
+    return std.fmt.bytesToHex(hasher.finalResult(), .lower);
+}
+
+// Applies the schema files in a transaction and records their checksum in the
+// sqlc_schema_version table. Nothing is applied when the checksum is already
+// recorded, and error.SchemaChanged is returned when a different one is.
+pub fn migrate(conn: *pg.Conn) !void {
+    const sum = checksum();
+    try conn.begin();
+    errdefer conn.rollback() catch {};
+    _ = try conn.exec(version_table_sql, .{});
+    const recorded: ?bool = blk: {
+        var result = try conn.query("SELECT checksum FROM sqlc_schema_version", .{});
+        defer result.deinit();
+        const row = (try result.next()) orelse break :blk null;
+        break :blk std.mem.eql(u8, row.get([]const u8, 0), &sum);
+    };
+    if (recorded) |same| {
+        if (!same) {
+            return error.SchemaChanged;
+        }
+        return conn.commit();
+    }
+    for (files) |file| {
+        _ = try conn.exec(file.sql, .{});
+    }
+    _ = try conn.exec("INSERT INTO sqlc_schema_version (checksum) VALUES ($1)", .{@as([]const u8, &sum)});
+    try conn.commit();
+}
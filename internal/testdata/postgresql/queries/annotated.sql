@@ -0,0 +1,14 @@
+-- name: ListUserEmails :many
+-- Streams the id and email of every user.
+-- @zig use_context=true
+SELECT id, email FROM users
+ORDER BY id ASC;
+
+-- name: FindUser :one
+-- @zig pg_error_unions route=primary
+SELECT * FROM users
+WHERE email = $1 LIMIT 1;
+
+-- name: ArchiveUser :exec
+-- @zig param_struct
+UPDATE users SET archived_at = NOW() WHERE id = $1;
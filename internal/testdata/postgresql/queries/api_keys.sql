@@ -0,0 +1,8 @@
+-- name: GetAPIKey :one
+SELECT * FROM api_keys
+WHERE id = $1;
+
+-- name: ListAPIKeySecrets :many
+SELECT id, secret, expires_at FROM api_keys
+WHERE user_id = $1
+ORDER BY created_at ASC;
@@ -0,0 +1,10 @@
+-- name: GetInvoices :many
+SELECT * FROM billing.invoices
+ORDER BY id ASC;
+
+-- name: GetInvoiceStatus :one
+SELECT status FROM billing.invoices
+WHERE id = $1;
+
+-- name: CreateInvoice :exec
+INSERT INTO billing.invoices (user_id, status, amount, memo) VALUES ($1, $2, $3, $4);
@@ -0,0 +1,41 @@
+// Command record is a sqlc plugin that writes the GenerateRequest it receives
+// to request.json, for use as a fixture in the generator tests.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/sqlc-dev/plugin-sdk-go/codegen"
+	"github.com/sqlc-dev/plugin-sdk-go/plugin"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func main() {
+	codegen.Run(func(_ context.Context, req *plugin.GenerateRequest) (*plugin.GenerateResponse, error) {
+		// The built-in schemas are not used by the generator and would make up
+		// most of the recording
+		var schemas []*plugin.Schema
+		for _, schema := range req.GetCatalog().GetSchemas() {
+			if schema.GetName() != "pg_catalog" && schema.GetName() != "information_schema" {
+				schemas = append(schemas, schema)
+			}
+		}
+		req.Catalog.Schemas = schemas
+		contents, err := protojson.Marshal(req)
+		if err != nil {
+			return nil, err
+		}
+		// protojson output is deliberately unstable, so reformat it to keep
+		// recordings reproducible
+		var out bytes.Buffer
+		if err := json.Indent(&out, contents, "", "  "); err != nil {
+			return nil, err
+		}
+		out.WriteByte('\n')
+		return &plugin.GenerateResponse{
+			Files: []*plugin.File{{Name: "request.json", Contents: out.Bytes()}},
+		}, nil
+	})
+}
@@ -0,0 +1,100 @@
+// Generated with sqlc v1.28.0
+ 
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const zqlite = @import("zqlite");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(zqlite.Conn);
+pub const PoolQuerier = Querier(*zqlite.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct{
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+        
+        const delete_attachment_sql = 
+            \\DELETE FROM attachments WHERE id = ?
+        ;
+
+        pub fn deleteAttachment(self: Self, id: i64) !void {
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+
+            try conn.exec(delete_attachment_sql, .{ 
+                id,
+            });
+        }
+
+        const get_attachment_sql = 
+            \\SELECT id, user_id, name, data, thumbnail FROM attachments
+            \\WHERE id = ?
+        ;
+
+        pub fn getAttachment(self: Self, id: i64) !models.Attachment {
+            const allocator = self.allocator;
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+
+            var rows = try conn.rows(get_attachment_sql, .{ 
+                id,
+            });
+            defer rows.deinit();
+            if (rows.err) |err| {
+                return err;
+            }
+            const row = rows.next() orelse return error.NotFound;
+
+            const row_id = row.int(0);
+            const row_user_id = row.int(1);
+            const row_name = try allocator.dupe(u8, row.text(2));
+            errdefer allocator.free(row_name);
+            const row_data = try allocator.dupe(u8, row.blob(3));
+            errdefer allocator.free(row_data);
+
+            const maybe_thumbnail = row.nullableBlob(4);
+            const row_thumbnail: ?zqlite.Blob = blk: {
+                if (maybe_thumbnail) |field| {
+                    break :blk try allocator.dupe(u8, field);
+                }
+                break :blk null;
+            };
+            errdefer if (row_thumbnail) |field| {
+                allocator.free(field);
+            };
+
+            return .{
+                .__allocator = allocator,
+                .id = row_id,
+                .user_id = row_user_id,
+                .name = row_name,
+                .data = row_data,
+                .thumbnail = row_thumbnail,
+            };
+        }
+
+    };
+}
\ No newline at end of file
@@ -0,0 +1,84 @@
+// Generated with sqlc v1.28.0
+ 
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const zqlite = @import("zqlite");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(zqlite.Conn);
+pub const PoolQuerier = Querier(*zqlite.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct{
+        const Self = @This();
+        
+        conn: T,
+
+        pub fn init(conn: T) Self {
+            return .{ .conn = conn };
+        }
+        
+        const delete_attachment_sql = 
+            \\DELETE FROM attachments WHERE id = ?
+        ;
+
+        pub fn deleteAttachment(self: Self, id: i64) !void {
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+
+            try conn.exec(delete_attachment_sql, .{ 
+                id,
+            });
+        }
+
+        const get_attachment_sql = 
+            \\SELECT id, user_id, name, data, thumbnail FROM attachments
+            \\WHERE id = ?
+        ;
+
+        pub fn getAttachment(self: Self, ctx: anytype, id: i64) !void {
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+
+            var rows = try conn.rows(get_attachment_sql, .{ 
+                id,
+            });
+            defer rows.deinit();
+            if (rows.err) |err| {
+                return err;
+            }
+            const row = rows.next() orelse return error.NotFound;
+
+            const row_id = row.int(0);
+            const row_user_id = row.int(1);
+            const row_name = row.text(2);
+            const row_data = row.blob(3);
+            const row_thumbnail = row.nullableBlob(4);
+            try ctx.handle(.{
+                .id = row_id,
+                .user_id = row_user_id,
+                .name = row_name,
+                .data = row_data,
+                .thumbnail = row_thumbnail,
+            });
+        }
+
+    };
+}
\ No newline at end of file
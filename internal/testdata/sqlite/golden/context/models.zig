@@ -0,0 +1,24 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+pub const Attachment = struct {
+    id: i64,
+    user_id: i64,
+    name: []const u8,
+    data: []const u8,
+    thumbnail: ?[]const u8,
+};
+
+pub const User = struct {
+    id: i64,
+    name: []const u8,
+    email: []const u8,
+    password: []const u8,
+    salary: ?f64,
+    notes: ?[]const u8,
+    created_at: i64,
+    updated_at: i64,
+    archived_at: ?i64,
+};
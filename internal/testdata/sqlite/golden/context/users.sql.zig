@@ -0,0 +1,254 @@
+// Generated with sqlc v1.28.0
+ 
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const zqlite = @import("zqlite");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(zqlite.Conn);
+pub const PoolQuerier = Querier(*zqlite.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct{
+        const Self = @This();
+        
+        conn: T,
+
+        pub fn init(conn: T) Self {
+            return .{ .conn = conn };
+        }
+        
+        const create_user_sql = 
+            \\INSERT INTO users (
+            \\    name, 
+            \\    email, 
+            \\    password, 
+            \\    salary
+            \\) VALUES (
+            \\    ?, ?, ?, ?
+            \\)
+        ;
+
+        pub const CreateUserParams = struct {
+            name: []const u8,
+            email: []const u8,
+            password: []const u8,
+            salary: ?f64 = null,
+        };
+
+        pub fn createUser(self: Self, create_user_params: CreateUserParams) !void {
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+
+            try conn.exec(create_user_sql, .{ 
+                create_user_params.name,
+                create_user_params.email,
+                create_user_params.password,
+                create_user_params.salary,
+            });
+        }
+
+        const get_user_sql = 
+            \\SELECT id, name, email, password, salary, notes, created_at, updated_at, archived_at FROM users
+            \\WHERE id = ? LIMIT 1
+        ;
+
+        pub fn getUser(self: Self, ctx: anytype, id: i64) !void {
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+
+            var rows = try conn.rows(get_user_sql, .{ 
+                id,
+            });
+            defer rows.deinit();
+            if (rows.err) |err| {
+                return err;
+            }
+            const row = rows.next() orelse return error.NotFound;
+
+            const row_id = row.int(0);
+            const row_name = row.text(1);
+            const row_email = row.text(2);
+            const row_password = row.text(3);
+            const row_salary = row.nullableFloat(4);
+            const row_notes = row.nullableText(5);
+            const row_created_at = row.int(6);
+            const row_updated_at = row.int(7);
+            const row_archived_at = row.nullableInt(8);
+            try ctx.handle(.{
+                .id = row_id,
+                .name = row_name,
+                .email = row_email,
+                .password = row_password,
+                .salary = row_salary,
+                .notes = row_notes,
+                .created_at = row_created_at,
+                .updated_at = row_updated_at,
+                .archived_at = row_archived_at,
+            });
+        }
+
+        const get_user_emails_sql = 
+            \\SELECT id, email FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetUserEmailsRow = struct {
+            id: i64,
+            email: []const u8,
+        };
+
+        pub fn getUserEmails(self: Self, ctx: anytype) !void {
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+
+            var rows = try conn.rows(get_user_emails_sql, .{});
+            defer rows.deinit();
+            while (rows.next()) |row| {
+                const row_id = row.int(0);
+                const row_email = row.text(1);
+                try ctx.handle(.{
+                    .id = row_id,
+                    .email = row_email,
+                });
+            }
+            if (rows.err) |err| {
+                return err;
+            }
+        }
+
+        const get_user_id_by_email_sql = 
+            \\SELECT id FROM users
+            \\WHERE email = ? LIMIT 1
+        ;
+
+        pub fn getUserIDByEmail(self: Self, ctx: anytype, email: []const u8) !void {
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+
+            var rows = try conn.rows(get_user_id_by_email_sql, .{ 
+                email,
+            });
+            defer rows.deinit();
+            if (rows.err) |err| {
+                return err;
+            }
+            const row = rows.next() orelse return error.NotFound;
+
+            const row_id = row.int(0);
+            try ctx.handle(row_id);
+        }
+
+        const get_user_i_ds_by_salary_range_sql = 
+            \\SELECT id FROM users
+            \\WHERE salary >= ? AND salary <= ?
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getUserIDsBySalaryRange(self: Self, ctx: anytype, salary_1: f64, salary_2: f64) !void {
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+
+            var rows = try conn.rows(get_user_i_ds_by_salary_range_sql, .{ 
+                salary_1,
+                salary_2,
+            });
+            defer rows.deinit();
+            while (rows.next()) |row| {
+                const row_id = row.int(0);
+                try ctx.handle(row_id);
+            }
+            if (rows.err) |err| {
+                return err;
+            }
+        }
+
+        const get_users_sql = 
+            \\SELECT id, name, email, password, salary, notes, created_at, updated_at, archived_at FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getUsers(self: Self, ctx: anytype) !void {
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+
+            var rows = try conn.rows(get_users_sql, .{});
+            defer rows.deinit();
+            while (rows.next()) |row| {
+                const row_id = row.int(0);
+                const row_name = row.text(1);
+                const row_email = row.text(2);
+                const row_password = row.text(3);
+                const row_salary = row.nullableFloat(4);
+                const row_notes = row.nullableText(5);
+                const row_created_at = row.int(6);
+                const row_updated_at = row.int(7);
+                const row_archived_at = row.nullableInt(8);
+                try ctx.handle(.{
+                    .id = row_id,
+                    .name = row_name,
+                    .email = row_email,
+                    .password = row_password,
+                    .salary = row_salary,
+                    .notes = row_notes,
+                    .created_at = row_created_at,
+                    .updated_at = row_updated_at,
+                    .archived_at = row_archived_at,
+                });
+            }
+            if (rows.err) |err| {
+                return err;
+            }
+        }
+
+    };
+}
\ No newline at end of file
@@ -0,0 +1,356 @@
+// Generated with sqlc v1.28.0
+ 
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const zqlite = @import("zqlite");
+const models = @import("models.zig");
+const catalog = @import("queries.zig");
+
+pub const ConnQuerier = Querier(zqlite.Conn, NoHooks);
+pub const PoolQuerier = Querier(*zqlite.Pool, NoHooks);
+
+pub fn Querier(comptime T: type, comptime Hooks: type) type {
+    return struct{
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(T, Hooks) {
+            return QuerierInterface(T, Hooks).init(Self, self);
+        }
+        
+        const delete_attachment_sql = 
+            \\DELETE FROM attachments WHERE id = ?
+        ;
+
+        pub fn deleteAttachment(self: Self, id: i64) !void {
+            const hook = QueryHook(Hooks).begin("deleteAttachment", delete_attachment_sql);
+            const result = self.deleteAttachmentUnhooked(id);
+            if (result) |value| {
+                hook.end(0, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn deleteAttachmentUnhooked(self: Self, id: i64) !void {
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+            var deadline = Deadline.init(500);
+            deadline.install(conn);
+            defer Deadline.uninstall(conn);
+
+            conn.exec(delete_attachment_sql, .{ 
+                id,
+            }) catch |err| return timeoutError(err);
+        }
+
+        const get_attachment_sql = 
+            \\SELECT id, user_id, name, data, thumbnail FROM attachments
+            \\WHERE id = ?
+        ;
+
+        pub fn getAttachment(self: Self, id: i64) !models.Attachment {
+            const hook = QueryHook(Hooks).begin("getAttachment", get_attachment_sql);
+            const result = self.getAttachmentUnhooked(id);
+            if (result) |value| {
+                hook.end(1, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getAttachmentUnhooked(self: Self, id: i64) !models.Attachment {
+            const allocator = self.allocator;
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+            var deadline = Deadline.init(500);
+            deadline.install(conn);
+            defer Deadline.uninstall(conn);
+
+            var rows = conn.rows(get_attachment_sql, .{ 
+                id,
+            }) catch |err| return timeoutError(err);
+            defer rows.deinit();
+            if (rows.err) |err| {
+                return timeoutError(err);
+            }
+            const row = rows.next() orelse return error.NotFound;
+
+            const row_id = row.int(0);
+            const row_user_id = row.int(1);
+            const row_name = try allocator.dupe(u8, row.text(2));
+            errdefer allocator.free(row_name);
+            const row_data = try allocator.dupe(u8, row.blob(3));
+            errdefer allocator.free(row_data);
+
+            const maybe_thumbnail = row.nullableBlob(4);
+            const row_thumbnail: ?zqlite.Blob = blk: {
+                if (maybe_thumbnail) |field| {
+                    break :blk try allocator.dupe(u8, field);
+                }
+                break :blk null;
+            };
+            errdefer if (row_thumbnail) |field| {
+                allocator.free(field);
+            };
+
+            return .{
+                .__allocator = allocator,
+                .id = row_id,
+                .user_id = row_user_id,
+                .name = row_name,
+                .data = row_data,
+                .thumbnail = row_thumbnail,
+            };
+        }
+
+    };
+}
+
+// The queries of this file, ordered by method name.
+pub const queries = [_]catalog.QueryInfo{
+    .{
+        .name = "deleteAttachment",
+        .sqlc_name = "DeleteAttachment",
+        .file = "attachments.sql",
+        .cmd = ":exec",
+        .sql = ConnQuerier.delete_attachment_sql,
+        .params = &.{
+            .{ .name = "id", .sql_type = "INTEGER", .nullable = false, .array = false },
+        },
+        .columns = &.{},
+    },
+    .{
+        .name = "getAttachment",
+        .sqlc_name = "GetAttachment",
+        .file = "attachments.sql",
+        .cmd = ":one",
+        .sql = ConnQuerier.get_attachment_sql,
+        .params = &.{
+            .{ .name = "id", .sql_type = "INTEGER", .nullable = false, .array = false },
+        },
+        .columns = &.{ "id", "user_id", "name", "data", "thumbnail" },
+    },
+};
+
+// Interrupts the statements running on a connection once the deadline has
+// passed, using the sqlite progress handler.
+const Deadline = struct {
+    at: i64,
+
+    fn init(timeout_ms: i64) Deadline {
+        return .{ .at = std.time.milliTimestamp() + timeout_ms };
+    }
+
+    fn install(self: *Deadline, conn: zqlite.Conn) void {
+        zqlite.c.sqlite3_progress_handler(conn.conn, 1000, interrupt, self);
+    }
+
+    fn uninstall(conn: zqlite.Conn) void {
+        zqlite.c.sqlite3_progress_handler(conn.conn, 0, null, null);
+    }
+
+    fn interrupt(ptr: ?*anyopaque) callconv(.c) c_int {
+        const self: *Deadline = @ptrCast(@alignCast(ptr));
+        return @intFromBool(std.time.milliTimestamp() >= self.at);
+    }
+};
+
+// Returns error.Timeout in place of err if the query was interrupted by its
+// deadline.
+fn timeoutError(err: anytype) @TypeOf(err) || error{Timeout} {
+    return if (@as(anyerror, err) == error.Interrupt) error.Timeout else err;
+}
+
+// Hooks for a Querier that does not observe its queries.
+pub const NoHooks = struct {};
+
+// Calls the optional callbacks declared by Hooks around a query:
+//
+//   pub fn beforeQuery(name: []const u8, sql: []const u8) void
+//   pub fn afterQuery(name: []const u8, duration_ns: u64, rows: ?usize, err: ?anyerror) void
+//
+// The name is the name of the query method. Rows is the number of rows
+// returned, or null for methods passing rows to a context. Callbacks that are
+// not declared are not called, and their overhead compiles away.
+fn QueryHook(comptime Hooks: type) type {
+    return struct {
+        name: []const u8,
+        start: if (@hasDecl(Hooks, "afterQuery")) i128 else void,
+
+        fn begin(name: []const u8, sql: []const u8) @This() {
+            if (@hasDecl(Hooks, "beforeQuery")) {
+                Hooks.beforeQuery(name, sql);
+            }
+            return .{
+                .name = name,
+                .start = if (@hasDecl(Hooks, "afterQuery")) std.time.nanoTimestamp() else {},
+            };
+        }
+
+        fn end(self: @This(), rows: ?usize, err: ?anyerror) void {
+            if (@hasDecl(Hooks, "afterQuery")) {
+                const duration: u64 = @intCast(@max(0, std.time.nanoTimestamp() - self.start));
+                Hooks.afterQuery(self.name, duration, rows, err);
+            }
+        }
+    };
+}
+
+pub const ConnQuerierInterface = QuerierInterface(zqlite.Conn, NoHooks);
+pub const PoolQuerierInterface = QuerierInterface(*zqlite.Pool, NoHooks);
+
+// A type erased interface over the methods of Querier(T). It can be backed by
+// a Querier or any other type declaring the same methods, such as a mock.
+// Methods taking a context are not part of the interface.
+pub fn QuerierInterface(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        ptr: *anyopaque,
+        vtable: *const VTable,
+
+        pub const VTable = struct {
+            deleteAttachment: *const fn (ptr: *anyopaque, id: i64) anyerror!void,
+            getAttachment: *const fn (ptr: *anyopaque, id: i64) anyerror!models.Attachment,
+        };
+
+        // Returns an interface calling the methods of impl.
+        pub fn init(comptime Impl: type, impl: *Impl) Self {
+            return .{ .ptr = impl, .vtable = &VTableFor(Impl).vtable };
+        }
+
+        fn VTableFor(comptime Impl: type) type {
+            return struct {
+                const vtable = VTable{
+                    .deleteAttachment = struct {
+                        fn call(ptr: *anyopaque, id: i64) anyerror!void {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.deleteAttachment(id);
+                        }
+                    }.call,
+                    .getAttachment = struct {
+                        fn call(ptr: *anyopaque, id: i64) anyerror!models.Attachment {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getAttachment(id);
+                        }
+                    }.call,
+                };
+            };
+        }
+
+        pub fn deleteAttachment(self: Self, id: i64) anyerror!void {
+            return self.vtable.deleteAttachment(self.ptr, id);
+        }
+
+        pub fn getAttachment(self: Self, id: i64) anyerror!models.Attachment {
+            return self.vtable.getAttachment(self.ptr, id);
+        }
+    };
+}
+
+pub const ConnMockQuerier = MockQuerier(zqlite.Conn, NoHooks);
+pub const PoolMockQuerier = MockQuerier(*zqlite.Pool, NoHooks);
+
+// Records the calls to a single MockQuerier method and the result to return.
+pub fn MockMethod(comptime Args: type, comptime Result: type) type {
+    return struct {
+        // The value returned by the method. Methods returning void succeed
+        // without a result.
+        result: ?Result = null,
+        // An error returned by the method instead of the result.
+        err: ?anyerror = null,
+        // Computes the result of the method from its arguments. Takes
+        // precedence over result and err when set.
+        handler: ?*const fn (args: Args) anyerror!Result = null,
+        // The number of calls expected by MockQuerier.verify, or null to
+        // accept any number of calls.
+        expected_calls: ?usize = null,
+        // The number of times the method was called.
+        calls: usize = 0,
+        // The arguments of the most recent call.
+        last_args: ?Args = null,
+
+        fn call(self: *@This(), args: Args) anyerror!Result {
+            self.calls += 1;
+            self.last_args = args;
+            if (self.handler) |handler| {
+                return handler(args);
+            }
+            if (self.err) |err| {
+                return err;
+            }
+            if (Result == void) {
+                return;
+            }
+            return self.result orelse error.UnexpectedCall;
+        }
+    };
+}
+
+// A mock implementation of the methods of Querier(T) for unit tests. Canned
+// results and expectations are set per method on the expect field, calls
+// without a result set return error.UnexpectedCall unless the method returns
+// void.
+pub fn MockQuerier(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        expect: struct {
+            deleteAttachment: MockMethod(struct { id: i64 }, void) = .{},
+            getAttachment: MockMethod(struct { id: i64 }, models.Attachment) = .{},
+        } = .{},
+
+        pub fn interface(self: *Self) QuerierInterface(T, Hooks) {
+            return QuerierInterface(T, Hooks).init(Self, self);
+        }
+
+        // Returns error.UnexpectedCallCount if any method with expected_calls
+        // set was called a different number of times.
+        pub fn verify(self: Self) !void {
+            inline for (std.meta.fields(@TypeOf(self.expect))) |field| {
+                const method = @field(self.expect, field.name);
+                if (method.expected_calls) |expected| {
+                    if (method.calls != expected) {
+                        return error.UnexpectedCallCount;
+                    }
+                }
+            }
+        }
+
+        pub fn deleteAttachment(self: *Self, id: i64) anyerror!void {
+            return self.expect.deleteAttachment.call(.{ .id = id });
+        }
+
+        pub fn getAttachment(self: *Self, id: i64) anyerror!models.Attachment {
+            return self.expect.getAttachment.call(.{ .id = id });
+        }
+    };
+}
\ No newline at end of file
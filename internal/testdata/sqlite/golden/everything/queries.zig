@@ -0,0 +1,30 @@
+// Generated with sqlc v1.28.0
+
+// Describes a generated query.
+pub const QueryInfo = struct {
+    // The name of the Querier method
+    name: []const u8,
+    // The name of the query in sqlc
+    sqlc_name: []const u8,
+    // The file the query was read from
+    file: []const u8,
+    // The sqlc command of the query, e.g. ":one"
+    cmd: []const u8,
+    sql: []const u8,
+    params: []const QueryParam,
+    // The names of the columns returned by the query
+    columns: []const []const u8,
+};
+
+// Describes a parameter of a generated query.
+pub const QueryParam = struct {
+    // The name of the method parameter or parameter struct field
+    name: []const u8,
+    sql_type: []const u8,
+    nullable: bool,
+    array: bool,
+};
+
+// The queries of all files, ordered by file and method name.
+pub const queries = @import("attachments.sql.zig").queries ++
+    @import("users.sql.zig").queries;
@@ -0,0 +1,1060 @@
+// Generated with sqlc v1.28.0
+ 
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const zqlite = @import("zqlite");
+const models = @import("models.zig");
+const catalog = @import("queries.zig");
+
+pub const ConnQuerier = Querier(zqlite.Conn, NoHooks);
+pub const PoolQuerier = Querier(*zqlite.Pool, NoHooks);
+
+pub fn Querier(comptime T: type, comptime Hooks: type) type {
+    return struct{
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(T, Hooks) {
+            return QuerierInterface(T, Hooks).init(Self, self);
+        }
+        
+        const create_user_sql = 
+            \\INSERT INTO users (
+            \\    name, 
+            \\    email, 
+            \\    password, 
+            \\    salary
+            \\) VALUES (
+            \\    ?, ?, ?, ?
+            \\)
+        ;
+
+        pub const CreateUserParams = struct {
+            name: []const u8,
+            email: []const u8,
+            password: []const u8,
+            salary: ?f64 = null,
+
+            pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+                _ = fmt;
+                _ = options;
+                try writer.writeAll("CreateUserParams{ .name = ");
+                try debug_format.string(writer, self.name);
+                try writer.writeAll(", .email = ");
+                try debug_format.string(writer, self.email);
+                try writer.writeAll(", .password = ");
+                try debug_format.string(writer, self.password);
+                try writer.writeAll(", .salary = ");
+                if (self.salary) |value| {
+                    try debug_format.any(writer, value);
+                } else {
+                    try writer.writeAll("null");
+                }
+                try writer.writeAll(" }");
+            }
+        };
+
+        pub fn createUser(self: Self, create_user_params: CreateUserParams) !void {
+            const hook = QueryHook(Hooks).begin("createUser", create_user_sql);
+            const result = self.createUserUnhooked(create_user_params);
+            if (result) |value| {
+                hook.end(0, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn createUserUnhooked(self: Self, create_user_params: CreateUserParams) !void {
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+            var deadline = Deadline.init(500);
+            deadline.install(conn);
+            defer Deadline.uninstall(conn);
+
+            conn.exec(create_user_sql, .{ 
+                create_user_params.name,
+                create_user_params.email,
+                create_user_params.password,
+                create_user_params.salary,
+            }) catch |err| return timeoutError(err);
+        }
+
+        const get_user_sql = 
+            \\SELECT id, name, email, password, salary, notes, created_at, updated_at, archived_at FROM users
+            \\WHERE id = ? LIMIT 1
+        ;
+
+        pub fn getUser(self: Self, id: i64) !models.User {
+            const hook = QueryHook(Hooks).begin("getUser", get_user_sql);
+            const result = self.getUserUnhooked(id);
+            if (result) |value| {
+                hook.end(1, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserUnhooked(self: Self, id: i64) !models.User {
+            const allocator = self.allocator;
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+            var deadline = Deadline.init(500);
+            deadline.install(conn);
+            defer Deadline.uninstall(conn);
+
+            var rows = conn.rows(get_user_sql, .{ 
+                id,
+            }) catch |err| return timeoutError(err);
+            defer rows.deinit();
+            if (rows.err) |err| {
+                return timeoutError(err);
+            }
+            const row = rows.next() orelse return error.NotFound;
+
+            const row_id = row.int(0);
+            const row_name = try allocator.dupe(u8, row.text(1));
+            errdefer allocator.free(row_name);
+            const row_email = try allocator.dupe(u8, row.text(2));
+            errdefer allocator.free(row_email);
+            const row_password = try allocator.dupe(u8, row.text(3));
+            errdefer allocator.free(row_password);
+            const row_salary = row.nullableFloat(4);
+
+            const maybe_notes = row.nullableText(5);
+            const row_notes: ?[]const u8 = blk: {
+                if (maybe_notes) |field| {
+                    break :blk try allocator.dupe(u8, field);
+                }
+                break :blk null;
+            };
+            errdefer if (row_notes) |field| {
+                allocator.free(field);
+            };
+            const row_created_at = row.int(6);
+            const row_updated_at = row.int(7);
+            const row_archived_at = row.nullableInt(8);
+
+            return .{
+                .__allocator = allocator,
+                .id = row_id,
+                .name = row_name,
+                .email = row_email,
+                .password = row_password,
+                .salary = row_salary,
+                .notes = row_notes,
+                .created_at = row_created_at,
+                .updated_at = row_updated_at,
+                .archived_at = row_archived_at,
+            };
+        }
+
+        const get_user_emails_sql = 
+            \\SELECT id, email FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub const GetUserEmailsRow = struct {
+            __allocator: Allocator,
+
+            id: i64,
+            email: []const u8,
+
+            pub fn deinit(self: *const GetUserEmailsRow) void {
+                self.__allocator.free(self.email);
+            }
+
+            pub fn jsonStringify(self: @This(), jw: anytype) !void {
+                try jw.beginObject();
+                try jw.objectField("id");
+                try jw.write(self.id);
+                try jw.objectField("email");
+                try jw.write(self.email);
+                try jw.endObject();
+            }
+
+            pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+                _ = fmt;
+                _ = options;
+                try writer.writeAll("GetUserEmailsRow{ .id = ");
+                try debug_format.any(writer, self.id);
+                try writer.writeAll(", .email = ");
+                try debug_format.string(writer, self.email);
+                try writer.writeAll(" }");
+            }
+
+            // Returns a deep copy of the struct owned by allocator, to be freed with
+            // deinit.
+            pub fn clone(self: @This(), allocator: Allocator) !@This() {
+                return deep.clone(allocator, self);
+            }
+
+            // Reports whether all fields of the structs hold equal values.
+            pub fn eql(self: @This(), other: @This()) bool {
+                return deep.eql(self, other);
+            }
+
+            // Hashes the values of all fields, consistent with eql.
+            pub fn hash(self: @This()) u64 {
+                var hasher = std.hash.Wyhash.init(0);
+                deep.hash(&hasher, self);
+                return hasher.final();
+            }
+        };
+
+        pub fn getUserEmails(self: Self) ![]GetUserEmailsRow {
+            const hook = QueryHook(Hooks).begin("getUserEmails", get_user_emails_sql);
+            const result = self.getUserEmailsUnhooked();
+            if (result) |value| {
+                hook.end(value.len, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserEmailsUnhooked(self: Self) ![]GetUserEmailsRow {
+            const allocator = self.allocator;
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+            var deadline = Deadline.init(500);
+            deadline.install(conn);
+            defer Deadline.uninstall(conn);
+
+            var rows = conn.rows(get_user_emails_sql, .{}) catch |err| return timeoutError(err);
+            defer rows.deinit();
+            var out = std.ArrayList(GetUserEmailsRow).init(allocator);
+            defer out.deinit();
+            while (rows.next()) |row| {
+                const row_id = row.int(0);
+                const row_email = try allocator.dupe(u8, row.text(1));
+                errdefer allocator.free(row_email);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .email = row_email,
+                });
+            }
+            if (rows.err) |err| {
+                return timeoutError(err);
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getUserEmails along with the slice
+        // holding them.
+        pub fn freeGetUserEmails(self: Self, rows: []const GetUserEmailsRow) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+        const get_user_id_by_email_sql = 
+            \\SELECT id FROM users
+            \\WHERE email = ? LIMIT 1
+        ;
+
+        pub fn getUserIDByEmail(self: Self, email: []const u8) !i64 {
+            const hook = QueryHook(Hooks).begin("getUserIDByEmail", get_user_id_by_email_sql);
+            const result = self.getUserIDByEmailUnhooked(email);
+            if (result) |value| {
+                hook.end(1, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserIDByEmailUnhooked(self: Self, email: []const u8) !i64 {
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+            var deadline = Deadline.init(500);
+            deadline.install(conn);
+            defer Deadline.uninstall(conn);
+
+            var rows = conn.rows(get_user_id_by_email_sql, .{ 
+                email,
+            }) catch |err| return timeoutError(err);
+            defer rows.deinit();
+            if (rows.err) |err| {
+                return timeoutError(err);
+            }
+            const row = rows.next() orelse return error.NotFound;
+
+            const row_id = row.int(0);
+
+            return row_id;
+        }
+
+        const get_user_i_ds_by_salary_range_sql = 
+            \\SELECT id FROM users
+            \\WHERE salary >= ? AND salary <= ?
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getUserIDsBySalaryRange(self: Self, salary_1: f64, salary_2: f64) ![]i64 {
+            const hook = QueryHook(Hooks).begin("getUserIDsBySalaryRange", get_user_i_ds_by_salary_range_sql);
+            const result = self.getUserIDsBySalaryRangeUnhooked(salary_1, salary_2);
+            if (result) |value| {
+                hook.end(value.len, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUserIDsBySalaryRangeUnhooked(self: Self, salary_1: f64, salary_2: f64) ![]i64 {
+            const allocator = self.allocator;
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+            var deadline = Deadline.init(500);
+            deadline.install(conn);
+            defer Deadline.uninstall(conn);
+
+            var rows = conn.rows(get_user_i_ds_by_salary_range_sql, .{ 
+                salary_1,
+                salary_2,
+            }) catch |err| return timeoutError(err);
+            defer rows.deinit();
+            var out = std.ArrayList(i64).init(allocator);
+            defer out.deinit();
+            while (rows.next()) |row| {
+                const row_id = row.int(0);
+                try out.append(row_id);
+            }
+            if (rows.err) |err| {
+                return timeoutError(err);
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        const get_users_sql = 
+            \\SELECT id, name, email, password, salary, notes, created_at, updated_at, archived_at FROM users
+            \\ORDER BY id ASC
+        ;
+
+        pub fn getUsers(self: Self) ![]models.User {
+            const hook = QueryHook(Hooks).begin("getUsers", get_users_sql);
+            const result = self.getUsersUnhooked();
+            if (result) |value| {
+                hook.end(value.len, null);
+                return value;
+            } else |err| {
+                hook.end(0, err);
+                return err;
+            }
+        }
+
+        fn getUsersUnhooked(self: Self) ![]models.User {
+            const allocator = self.allocator;
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+            var deadline = Deadline.init(500);
+            deadline.install(conn);
+            defer Deadline.uninstall(conn);
+
+            var rows = conn.rows(get_users_sql, .{}) catch |err| return timeoutError(err);
+            defer rows.deinit();
+            var out = std.ArrayList(models.User).init(allocator);
+            defer out.deinit();
+            while (rows.next()) |row| {
+                const row_id = row.int(0);
+                const row_name = try allocator.dupe(u8, row.text(1));
+                errdefer allocator.free(row_name);
+                const row_email = try allocator.dupe(u8, row.text(2));
+                errdefer allocator.free(row_email);
+                const row_password = try allocator.dupe(u8, row.text(3));
+                errdefer allocator.free(row_password);
+                const row_salary = row.nullableFloat(4);
+
+                const maybe_notes = row.nullableText(5);
+                const row_notes: ?[]const u8 = blk: {
+                    if (maybe_notes) |field| {
+                        break :blk try allocator.dupe(u8, field);
+                    }
+                    break :blk null;
+                };
+                errdefer if (row_notes) |field| {
+                    allocator.free(field);
+                };
+                const row_created_at = row.int(6);
+                const row_updated_at = row.int(7);
+                const row_archived_at = row.nullableInt(8);
+                try out.append(.{
+                    .__allocator = allocator,
+                    .id = row_id,
+                    .name = row_name,
+                    .email = row_email,
+                    .password = row_password,
+                    .salary = row_salary,
+                    .notes = row_notes,
+                    .created_at = row_created_at,
+                    .updated_at = row_updated_at,
+                    .archived_at = row_archived_at,
+                });
+            }
+            if (rows.err) |err| {
+                return timeoutError(err);
+            }
+
+            return try out.toOwnedSlice();
+        }
+
+        // Frees the rows returned by getUsers along with the slice
+        // holding them.
+        pub fn freeGetUsers(self: Self, rows: []const models.User) void {
+            const allocator = self.allocator;
+            for (rows) |row| {
+                row.deinit();
+            }
+            allocator.free(rows);
+        }
+
+    };
+}
+
+// The queries of this file, ordered by method name.
+pub const queries = [_]catalog.QueryInfo{
+    .{
+        .name = "createUser",
+        .sqlc_name = "CreateUser",
+        .file = "users.sql",
+        .cmd = ":exec",
+        .sql = ConnQuerier.create_user_sql,
+        .params = &.{
+            .{ .name = "name", .sql_type = "TEXT", .nullable = false, .array = false },
+            .{ .name = "email", .sql_type = "TEXT", .nullable = false, .array = false },
+            .{ .name = "password", .sql_type = "TEXT", .nullable = false, .array = false },
+            .{ .name = "salary", .sql_type = "NUMERIC(10,2)", .nullable = true, .array = false },
+        },
+        .columns = &.{},
+    },
+    .{
+        .name = "getUser",
+        .sqlc_name = "GetUser",
+        .file = "users.sql",
+        .cmd = ":one",
+        .sql = ConnQuerier.get_user_sql,
+        .params = &.{
+            .{ .name = "id", .sql_type = "INTEGER", .nullable = false, .array = false },
+        },
+        .columns = &.{ "id", "name", "email", "password", "salary", "notes", "created_at", "updated_at", "archived_at" },
+    },
+    .{
+        .name = "getUserEmails",
+        .sqlc_name = "GetUserEmails",
+        .file = "users.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_user_emails_sql,
+        .params = &.{},
+        .columns = &.{ "id", "email" },
+    },
+    .{
+        .name = "getUserIDByEmail",
+        .sqlc_name = "GetUserIDByEmail",
+        .file = "users.sql",
+        .cmd = ":one",
+        .sql = ConnQuerier.get_user_id_by_email_sql,
+        .params = &.{
+            .{ .name = "email", .sql_type = "TEXT", .nullable = false, .array = false },
+        },
+        .columns = &.{ "id" },
+    },
+    .{
+        .name = "getUserIDsBySalaryRange",
+        .sqlc_name = "GetUserIDsBySalaryRange",
+        .file = "users.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_user_i_ds_by_salary_range_sql,
+        .params = &.{
+            .{ .name = "salary_1", .sql_type = "NUMERIC(10,2)", .nullable = true, .array = false },
+            .{ .name = "salary_2", .sql_type = "NUMERIC(10,2)", .nullable = true, .array = false },
+        },
+        .columns = &.{ "id" },
+    },
+    .{
+        .name = "getUsers",
+        .sqlc_name = "GetUsers",
+        .file = "users.sql",
+        .cmd = ":many",
+        .sql = ConnQuerier.get_users_sql,
+        .params = &.{},
+        .columns = &.{ "id", "name", "email", "password", "salary", "notes", "created_at", "updated_at", "archived_at" },
+    },
+};
+
+// Writes values that std.json cannot write as is in generated jsonStringify
+// methods.
+const json_format = struct {
+    // Writes binary data as a base64 encoded string.
+    fn bytes(jw: anytype, value: []const u8) !void {
+        try jw.beginWriteRaw();
+        try jw.stream.writeByte('"');
+        try std.base64.standard.Encoder.encodeWriter(jw.stream, value);
+        try jw.stream.writeByte('"');
+        jw.endWriteRaw();
+    }
+
+    // Writes a UUID in its canonical form, e.g.
+    // "123e4567-e89b-12d3-a456-426614174000".
+    fn uuid(jw: anytype, value: [16]u8) !void {
+        const hex = "0123456789abcdef";
+        var buf: [36]u8 = undefined;
+        var i: usize = 0;
+        for (value, 0..) |byte, idx| {
+            if (idx == 4 or idx == 6 or idx == 8 or idx == 10) {
+                buf[i] = '-';
+                i += 1;
+            }
+            buf[i] = hex[byte >> 4];
+            buf[i + 1] = hex[byte & 0x0f];
+            i += 2;
+        }
+        try jw.write(buf[0..]);
+    }
+
+    // Writes a timestamp in microseconds since the Unix epoch as an RFC 3339
+    // string in UTC, e.g. "2024-01-02T03:04:05.000006Z". Timestamps before the
+    // epoch are written as numbers.
+    fn timestamp(jw: anytype, value: i64) !void {
+        if (value < 0) {
+            return jw.write(value);
+        }
+        try jw.beginWriteRaw();
+        try jw.stream.writeByte('"');
+        try printTimestamp(jw.stream, @intCast(value));
+        try jw.stream.writeByte('"');
+        jw.endWriteRaw();
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn array(jw: anytype, values: anytype, comptime writeValue: anytype) !void {
+        try jw.beginArray();
+        for (values) |value| {
+            try writeValue(jw, value);
+        }
+        try jw.endArray();
+    }
+};
+
+// Walks the fields of generated structs for the clone, eql and hash methods.
+// Slices are followed and the internal allocator field is skipped.
+const deep = struct {
+    fn clone(allocator: Allocator, value: anytype) !@TypeOf(value) {
+        const T = @TypeOf(value);
+        switch (@typeInfo(T)) {
+            .optional => return if (value) |v| try clone(allocator, v) else null,
+            .pointer => |ptr| {
+                switch (@typeInfo(ptr.child)) {
+                    .optional, .pointer, .@"struct" => {},
+                    else => return allocator.dupe(ptr.child, value),
+                }
+                const out = try allocator.alloc(ptr.child, value.len);
+                errdefer allocator.free(out);
+                var cloned: usize = 0;
+                errdefer for (out[0..cloned]) |item| free(allocator, item);
+                for (value) |item| {
+                    out[cloned] = try clone(allocator, item);
+                    cloned += 1;
+                }
+                return out;
+            },
+            .@"struct" => |info| {
+                var out = value;
+                var cloned: usize = 0;
+                errdefer inline for (info.fields, 0..) |field, i| {
+                    if (i < cloned and !comptime isAllocator(field.name)) {
+                        free(allocator, @field(out, field.name));
+                    }
+                }
+                inline for (info.fields) |field| {
+                    if (comptime isAllocator(field.name)) {
+                        out.__allocator = allocator;
+                    } else {
+                        @field(out, field.name) = try clone(allocator, @field(value, field.name));
+                    }
+                    cloned += 1;
+                }
+                return out;
+            },
+            else => return value,
+        }
+    }
+
+    fn free(allocator: Allocator, value: anytype) void {
+        switch (@typeInfo(@TypeOf(value))) {
+            .optional => if (value) |v| free(allocator, v),
+            .pointer => {
+                for (value) |item| free(allocator, item);
+                allocator.free(value);
+            },
+            .@"struct" => |info| inline for (info.fields) |field| {
+                if (!comptime isAllocator(field.name)) {
+                    free(allocator, @field(value, field.name));
+                }
+            },
+            else => {},
+        }
+    }
+
+    fn eql(a: anytype, b: @TypeOf(a)) bool {
+        switch (@typeInfo(@TypeOf(a))) {
+            .optional => {
+                const x = a orelse return b == null;
+                const y = b orelse return false;
+                return eql(x, y);
+            },
+            .pointer => {
+                if (a.len != b.len) {
+                    return false;
+                }
+                for (a, b) |x, y| {
+                    if (!eql(x, y)) {
+                        return false;
+                    }
+                }
+                return true;
+            },
+            .array => return std.mem.eql(@typeInfo(@TypeOf(a)).array.child, &a, &b),
+            .@"struct" => |info| {
+                inline for (info.fields) |field| {
+                    if (!comptime isAllocator(field.name) and !eql(@field(a, field.name), @field(b, field.name))) {
+                        return false;
+                    }
+                }
+                return true;
+            },
+            else => return a == b,
+        }
+    }
+
+    fn hash(hasher: *std.hash.Wyhash, value: anytype) void {
+        switch (@typeInfo(@TypeOf(value))) {
+            .optional => {
+                hasher.update(&[_]u8{@intFromBool(value != null)});
+                if (value) |v| hash(hasher, v);
+            },
+            .pointer => {
+                hasher.update(std.mem.asBytes(&value.len));
+                for (value) |item| hash(hasher, item);
+            },
+            .@"struct" => |info| inline for (info.fields) |field| {
+                if (!comptime isAllocator(field.name)) {
+                    hash(hasher, @field(value, field.name));
+                }
+            },
+            else => hasher.update(std.mem.asBytes(&value)),
+        }
+    }
+
+    fn isAllocator(comptime name: []const u8) bool {
+        return std.mem.eql(u8, name, "__allocator");
+    }
+};
+
+// Prints the fields of generated structs in format methods.
+const debug_format = struct {
+    fn any(writer: anytype, value: anytype) !void {
+        try writer.print("{any}", .{value});
+    }
+
+    fn string(writer: anytype, value: []const u8) !void {
+        try writer.print("\"{}\"", .{std.zig.fmtEscapes(value)});
+    }
+
+    fn bytes(writer: anytype, value: []const u8) !void {
+        try writer.print("0x{}", .{std.fmt.fmtSliceHexLower(value)});
+    }
+
+    fn uuid(writer: anytype, value: [16]u8) !void {
+        try writer.print("{}-{}-{}-{}-{}", .{
+            std.fmt.fmtSliceHexLower(value[0..4]),
+            std.fmt.fmtSliceHexLower(value[4..6]),
+            std.fmt.fmtSliceHexLower(value[6..8]),
+            std.fmt.fmtSliceHexLower(value[8..10]),
+            std.fmt.fmtSliceHexLower(value[10..16]),
+        });
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC, e.g.
+    // 2024-01-02T03:04:05.000006Z. Timestamps before the epoch are printed as
+    // numbers.
+    fn timestamp(writer: anytype, value: i64) !void {
+        if (value < 0) {
+            return writer.print("{d}", .{value});
+        }
+        try printTimestamp(writer, @intCast(value));
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn @"enum"(writer: anytype, value: anytype) !void {
+        try writer.print(".{s}", .{@tagName(value)});
+    }
+
+    fn array(writer: anytype, values: anytype, comptime formatValue: anytype) !void {
+        try writer.writeAll("{ ");
+        for (values, 0..) |value, i| {
+            if (i > 0) {
+                try writer.writeAll(", ");
+            }
+            try formatValue(writer, value);
+        }
+        try writer.writeAll(" }");
+    }
+};
+
+// Interrupts the statements running on a connection once the deadline has
+// passed, using the sqlite progress handler.
+const Deadline = struct {
+    at: i64,
+
+    fn init(timeout_ms: i64) Deadline {
+        return .{ .at = std.time.milliTimestamp() + timeout_ms };
+    }
+
+    fn install(self: *Deadline, conn: zqlite.Conn) void {
+        zqlite.c.sqlite3_progress_handler(conn.conn, 1000, interrupt, self);
+    }
+
+    fn uninstall(conn: zqlite.Conn) void {
+        zqlite.c.sqlite3_progress_handler(conn.conn, 0, null, null);
+    }
+
+    fn interrupt(ptr: ?*anyopaque) callconv(.c) c_int {
+        const self: *Deadline = @ptrCast(@alignCast(ptr));
+        return @intFromBool(std.time.milliTimestamp() >= self.at);
+    }
+};
+
+// Returns error.Timeout in place of err if the query was interrupted by its
+// deadline.
+fn timeoutError(err: anytype) @TypeOf(err) || error{Timeout} {
+    return if (@as(anyerror, err) == error.Interrupt) error.Timeout else err;
+}
+
+// Hooks for a Querier that does not observe its queries.
+pub const NoHooks = struct {};
+
+// Calls the optional callbacks declared by Hooks around a query:
+//
+//   pub fn beforeQuery(name: []const u8, sql: []const u8) void
+//   pub fn afterQuery(name: []const u8, duration_ns: u64, rows: ?usize, err: ?anyerror) void
+//
+// The name is the name of the query method. Rows is the number of rows
+// returned, or null for methods passing rows to a context. Callbacks that are
+// not declared are not called, and their overhead compiles away.
+fn QueryHook(comptime Hooks: type) type {
+    return struct {
+        name: []const u8,
+        start: if (@hasDecl(Hooks, "afterQuery")) i128 else void,
+
+        fn begin(name: []const u8, sql: []const u8) @This() {
+            if (@hasDecl(Hooks, "beforeQuery")) {
+                Hooks.beforeQuery(name, sql);
+            }
+            return .{
+                .name = name,
+                .start = if (@hasDecl(Hooks, "afterQuery")) std.time.nanoTimestamp() else {},
+            };
+        }
+
+        fn end(self: @This(), rows: ?usize, err: ?anyerror) void {
+            if (@hasDecl(Hooks, "afterQuery")) {
+                const duration: u64 = @intCast(@max(0, std.time.nanoTimestamp() - self.start));
+                Hooks.afterQuery(self.name, duration, rows, err);
+            }
+        }
+    };
+}
+
+pub const ConnQuerierInterface = QuerierInterface(zqlite.Conn, NoHooks);
+pub const PoolQuerierInterface = QuerierInterface(*zqlite.Pool, NoHooks);
+
+// A type erased interface over the methods of Querier(T). It can be backed by
+// a Querier or any other type declaring the same methods, such as a mock.
+// Methods taking a context are not part of the interface.
+pub fn QuerierInterface(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        ptr: *anyopaque,
+        vtable: *const VTable,
+
+        pub const VTable = struct {
+            createUser: *const fn (ptr: *anyopaque, create_user_params: Querier(T, Hooks).CreateUserParams) anyerror!void,
+            getUser: *const fn (ptr: *anyopaque, id: i64) anyerror!models.User,
+            getUserEmails: *const fn (ptr: *anyopaque) anyerror![]Querier(T, Hooks).GetUserEmailsRow,
+            getUserIDByEmail: *const fn (ptr: *anyopaque, email: []const u8) anyerror!i64,
+            getUserIDsBySalaryRange: *const fn (ptr: *anyopaque, salary_1: f64, salary_2: f64) anyerror![]i64,
+            getUsers: *const fn (ptr: *anyopaque) anyerror![]models.User,
+        };
+
+        // Returns an interface calling the methods of impl.
+        pub fn init(comptime Impl: type, impl: *Impl) Self {
+            return .{ .ptr = impl, .vtable = &VTableFor(Impl).vtable };
+        }
+
+        fn VTableFor(comptime Impl: type) type {
+            return struct {
+                const vtable = VTable{
+                    .createUser = struct {
+                        fn call(ptr: *anyopaque, create_user_params: Querier(T, Hooks).CreateUserParams) anyerror!void {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.createUser(create_user_params);
+                        }
+                    }.call,
+                    .getUser = struct {
+                        fn call(ptr: *anyopaque, id: i64) anyerror!models.User {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUser(id);
+                        }
+                    }.call,
+                    .getUserEmails = struct {
+                        fn call(ptr: *anyopaque) anyerror![]Querier(T, Hooks).GetUserEmailsRow {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserEmails();
+                        }
+                    }.call,
+                    .getUserIDByEmail = struct {
+                        fn call(ptr: *anyopaque, email: []const u8) anyerror!i64 {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserIDByEmail(email);
+                        }
+                    }.call,
+                    .getUserIDsBySalaryRange = struct {
+                        fn call(ptr: *anyopaque, salary_1: f64, salary_2: f64) anyerror![]i64 {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUserIDsBySalaryRange(salary_1, salary_2);
+                        }
+                    }.call,
+                    .getUsers = struct {
+                        fn call(ptr: *anyopaque) anyerror![]models.User {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getUsers();
+                        }
+                    }.call,
+                };
+            };
+        }
+
+        pub fn createUser(self: Self, create_user_params: Querier(T, Hooks).CreateUserParams) anyerror!void {
+            return self.vtable.createUser(self.ptr, create_user_params);
+        }
+
+        pub fn getUser(self: Self, id: i64) anyerror!models.User {
+            return self.vtable.getUser(self.ptr, id);
+        }
+
+        pub fn getUserEmails(self: Self) anyerror![]Querier(T, Hooks).GetUserEmailsRow {
+            return self.vtable.getUserEmails(self.ptr);
+        }
+
+        pub fn getUserIDByEmail(self: Self, email: []const u8) anyerror!i64 {
+            return self.vtable.getUserIDByEmail(self.ptr, email);
+        }
+
+        pub fn getUserIDsBySalaryRange(self: Self, salary_1: f64, salary_2: f64) anyerror![]i64 {
+            return self.vtable.getUserIDsBySalaryRange(self.ptr, salary_1, salary_2);
+        }
+
+        pub fn getUsers(self: Self) anyerror![]models.User {
+            return self.vtable.getUsers(self.ptr);
+        }
+    };
+}
+
+pub const ConnMockQuerier = MockQuerier(zqlite.Conn, NoHooks);
+pub const PoolMockQuerier = MockQuerier(*zqlite.Pool, NoHooks);
+
+// Records the calls to a single MockQuerier method and the result to return.
+pub fn MockMethod(comptime Args: type, comptime Result: type) type {
+    return struct {
+        // The value returned by the method. Methods returning void succeed
+        // without a result.
+        result: ?Result = null,
+        // An error returned by the method instead of the result.
+        err: ?anyerror = null,
+        // Computes the result of the method from its arguments. Takes
+        // precedence over result and err when set.
+        handler: ?*const fn (args: Args) anyerror!Result = null,
+        // The number of calls expected by MockQuerier.verify, or null to
+        // accept any number of calls.
+        expected_calls: ?usize = null,
+        // The number of times the method was called.
+        calls: usize = 0,
+        // The arguments of the most recent call.
+        last_args: ?Args = null,
+
+        fn call(self: *@This(), args: Args) anyerror!Result {
+            self.calls += 1;
+            self.last_args = args;
+            if (self.handler) |handler| {
+                return handler(args);
+            }
+            if (self.err) |err| {
+                return err;
+            }
+            if (Result == void) {
+                return;
+            }
+            return self.result orelse error.UnexpectedCall;
+        }
+    };
+}
+
+// A mock implementation of the methods of Querier(T) for unit tests. Canned
+// results and expectations are set per method on the expect field, calls
+// without a result set return error.UnexpectedCall unless the method returns
+// void.
+pub fn MockQuerier(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        expect: struct {
+            createUser: MockMethod(struct { create_user_params: Querier(T, Hooks).CreateUserParams }, void) = .{},
+            getUser: MockMethod(struct { id: i64 }, models.User) = .{},
+            getUserEmails: MockMethod(struct {}, []Querier(T, Hooks).GetUserEmailsRow) = .{},
+            getUserIDByEmail: MockMethod(struct { email: []const u8 }, i64) = .{},
+            getUserIDsBySalaryRange: MockMethod(struct { salary_1: f64, salary_2: f64 }, []i64) = .{},
+            getUsers: MockMethod(struct {}, []models.User) = .{},
+        } = .{},
+
+        pub fn interface(self: *Self) QuerierInterface(T, Hooks) {
+            return QuerierInterface(T, Hooks).init(Self, self);
+        }
+
+        // Returns error.UnexpectedCallCount if any method with expected_calls
+        // set was called a different number of times.
+        pub fn verify(self: Self) !void {
+            inline for (std.meta.fields(@TypeOf(self.expect))) |field| {
+                const method = @field(self.expect, field.name);
+                if (method.expected_calls) |expected| {
+                    if (method.calls != expected) {
+                        return error.UnexpectedCallCount;
+                    }
+                }
+            }
+        }
+
+        pub fn createUser(self: *Self, create_user_params: Querier(T, Hooks).CreateUserParams) anyerror!void {
+            return self.expect.createUser.call(.{ .create_user_params = create_user_params });
+        }
+
+        pub fn getUser(self: *Self, id: i64) anyerror!models.User {
+            return self.expect.getUser.call(.{ .id = id });
+        }
+
+        pub fn getUserEmails(self: *Self) anyerror![]Querier(T, Hooks).GetUserEmailsRow {
+            return self.expect.getUserEmails.call(.{});
+        }
+
+        pub fn getUserIDByEmail(self: *Self, email: []const u8) anyerror!i64 {
+            return self.expect.getUserIDByEmail.call(.{ .email = email });
+        }
+
+        pub fn getUserIDsBySalaryRange(self: *Self, salary_1: f64, salary_2: f64) anyerror![]i64 {
+            return self.expect.getUserIDsBySalaryRange.call(.{ .salary_1 = salary_1, .salary_2 = salary_2 });
+        }
+
+        pub fn getUsers(self: *Self) anyerror![]models.User {
+            return self.expect.getUsers.call(.{});
+        }
+    };
+}
\ No newline at end of file
@@ -0,0 +1,53 @@
+// Generated with sqlc v1.28.0
+
+const zqlite = @import("zqlite");
+
+// A row of the attachments table. Fields default to deterministic values
+// valid for their columns, with nullable columns set to null.
+pub const Attachment = struct {
+    user_id: i64 = 0,
+    name: []const u8 = "name",
+    data: []const u8 = "",
+    thumbnail: ?[]const u8 = null,
+
+    const insert_sql = "INSERT INTO \"attachments\" (\"user_id\", \"name\", \"data\", \"thumbnail\") VALUES (?1, ?2, ?3, ?4)";
+
+    // Inserts the row using a connection.
+    pub fn insert(self: Attachment, conn: zqlite.Conn) !void {
+        try conn.exec(insert_sql, .{
+            self.user_id,
+            self.name,
+            zqlite.blob(self.data),
+            if (self.thumbnail) |value| zqlite.blob(value) else null,
+        });
+    }
+};
+
+// A row of the users table. Fields default to deterministic values
+// valid for their columns, with nullable columns set to null.
+pub const User = struct {
+    name: []const u8 = "name",
+    email: []const u8 = "email",
+    password: []const u8 = "password",
+    salary: ?f64 = null,
+    notes: ?[]const u8 = null,
+    created_at: i64 = 0,
+    updated_at: i64 = 0,
+    archived_at: ?i64 = null,
+
+    const insert_sql = "INSERT INTO \"users\" (\"name\", \"email\", \"password\", \"salary\", \"notes\", \"created_at\", \"updated_at\", \"archived_at\") VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8)";
+
+    // Inserts the row using a connection.
+    pub fn insert(self: User, conn: zqlite.Conn) !void {
+        try conn.exec(insert_sql, .{
+            self.name,
+            self.email,
+            self.password,
+            self.salary,
+            self.notes,
+            self.created_at,
+            self.updated_at,
+            self.archived_at,
+        });
+    }
+};
@@ -0,0 +1,167 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+pub const Attachment = struct {
+    __allocator: Allocator,
+
+    id: i64,
+    user_id: i64,
+    name: []const u8,
+    data: []const u8,
+    thumbnail: ?[]const u8,
+
+    pub fn deinit(self: *const Attachment) void {
+        self.__allocator.free(self.name);
+        self.__allocator.free(self.data);
+        if (self.thumbnail) |field| {
+            self.__allocator.free(field);
+        }
+    }
+
+    pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+        _ = fmt;
+        _ = options;
+        try writer.writeAll("Attachment{ .id = ");
+        try debug_format.any(writer, self.id);
+        try writer.writeAll(", .user_id = ");
+        try debug_format.any(writer, self.user_id);
+        try writer.writeAll(", .name = ");
+        try debug_format.string(writer, self.name);
+        try writer.writeAll(", .data = ");
+        try debug_format.bytes(writer, self.data);
+        try writer.writeAll(", .thumbnail = ");
+        if (self.thumbnail) |value| {
+            try debug_format.bytes(writer, value);
+        } else {
+            try writer.writeAll("null");
+        }
+        try writer.writeAll(" }");
+    }
+};
+
+pub const User = struct {
+    __allocator: Allocator,
+
+    id: i64,
+    name: []const u8,
+    email: []const u8,
+    password: []const u8,
+    salary: ?f64,
+    notes: ?[]const u8,
+    created_at: i64,
+    updated_at: i64,
+    archived_at: ?i64,
+
+    pub fn deinit(self: *const User) void {
+        self.__allocator.free(self.name);
+        self.__allocator.free(self.email);
+        self.__allocator.free(self.password);
+        if (self.notes) |field| {
+            self.__allocator.free(field);
+        }
+    }
+
+    pub fn format(self: @This(), comptime fmt: []const u8, options: std.fmt.FormatOptions, writer: anytype) !void {
+        _ = fmt;
+        _ = options;
+        try writer.writeAll("User{ .id = ");
+        try debug_format.any(writer, self.id);
+        try writer.writeAll(", .name = ");
+        try debug_format.string(writer, self.name);
+        try writer.writeAll(", .email = ");
+        try debug_format.string(writer, self.email);
+        try writer.writeAll(", .password = <redacted>");
+        try writer.writeAll(", .salary = ");
+        if (self.salary) |value| {
+            try debug_format.any(writer, value);
+        } else {
+            try writer.writeAll("null");
+        }
+        try writer.writeAll(", .notes = ");
+        if (self.notes) |value| {
+            try debug_format.string(writer, value);
+        } else {
+            try writer.writeAll("null");
+        }
+        try writer.writeAll(", .created_at = ");
+        try debug_format.any(writer, self.created_at);
+        try writer.writeAll(", .updated_at = ");
+        try debug_format.any(writer, self.updated_at);
+        try writer.writeAll(", .archived_at = ");
+        if (self.archived_at) |value| {
+            try debug_format.any(writer, value);
+        } else {
+            try writer.writeAll("null");
+        }
+        try writer.writeAll(" }");
+    }
+};
+
+// Prints the fields of generated structs in format methods.
+const debug_format = struct {
+    fn any(writer: anytype, value: anytype) !void {
+        try writer.print("{any}", .{value});
+    }
+
+    fn string(writer: anytype, value: []const u8) !void {
+        try writer.print("\"{}\"", .{std.zig.fmtEscapes(value)});
+    }
+
+    fn bytes(writer: anytype, value: []const u8) !void {
+        try writer.print("0x{}", .{std.fmt.fmtSliceHexLower(value)});
+    }
+
+    fn uuid(writer: anytype, value: [16]u8) !void {
+        try writer.print("{}-{}-{}-{}-{}", .{
+            std.fmt.fmtSliceHexLower(value[0..4]),
+            std.fmt.fmtSliceHexLower(value[4..6]),
+            std.fmt.fmtSliceHexLower(value[6..8]),
+            std.fmt.fmtSliceHexLower(value[8..10]),
+            std.fmt.fmtSliceHexLower(value[10..16]),
+        });
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC, e.g.
+    // 2024-01-02T03:04:05.000006Z. Timestamps before the epoch are printed as
+    // numbers.
+    fn timestamp(writer: anytype, value: i64) !void {
+        if (value < 0) {
+            return writer.print("{d}", .{value});
+        }
+        try printTimestamp(writer, @intCast(value));
+    }
+
+    // Prints a timestamp in microseconds since the Unix epoch in UTC.
+    fn printTimestamp(writer: anytype, micros: u64) !void {
+        const epoch_seconds = std.time.epoch.EpochSeconds{ .secs = micros / std.time.us_per_s };
+        const year_day = epoch_seconds.getEpochDay().calculateYearDay();
+        const month_day = year_day.calculateMonthDay();
+        const day_seconds = epoch_seconds.getDaySeconds();
+        try writer.print("{d:0>4}-{d:0>2}-{d:0>2}T{d:0>2}:{d:0>2}:{d:0>2}.{d:0>6}Z", .{
+            year_day.year,
+            month_day.month.numeric(),
+            month_day.day_index + 1,
+            day_seconds.getHoursIntoDay(),
+            day_seconds.getMinutesIntoHour(),
+            day_seconds.getSecondsIntoMinute(),
+            micros % std.time.us_per_s,
+        });
+    }
+
+    fn @"enum"(writer: anytype, value: anytype) !void {
+        try writer.print(".{s}", .{@tagName(value)});
+    }
+
+    fn array(writer: anytype, values: anytype, comptime formatValue: anytype) !void {
+        try writer.writeAll("{ ");
+        for (values, 0..) |value, i| {
+            if (i > 0) {
+                try writer.writeAll(", ");
+            }
+            try formatValue(writer, value);
+        }
+        try writer.writeAll(" }");
+    }
+};
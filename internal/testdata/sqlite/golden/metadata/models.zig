@@ -0,0 +1,104 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+pub const Attachment = struct {
+    __allocator: Allocator,
+
+    id: i64,
+    user_id: i64,
+    name: []const u8,
+    data: []const u8,
+    thumbnail: ?[]const u8,
+
+    pub fn deinit(self: *const Attachment) void {
+        self.__allocator.free(self.name);
+        self.__allocator.free(self.data);
+        if (self.thumbnail) |field| {
+            self.__allocator.free(field);
+        }
+    }
+
+    // The table the struct is read from and its columns, in the order of the
+    // struct fields.
+    pub const metadata = struct {
+        pub const table_name = "attachments";
+        pub const schema = "main";
+        pub const columns = [_]ColumnMetadata{
+            .{ .name = "id", .field = "id", .sql_type = "INTEGER", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "user_id", .field = "user_id", .sql_type = "INTEGER", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "name", .field = "name", .sql_type = "TEXT", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "data", .field = "data", .sql_type = "BLOB", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "thumbnail", .field = "thumbnail", .sql_type = "BLOB", .nullable = true, .array = false, .primary_key = false },
+        };
+
+        // Returns the metadata of the column held by a struct field.
+        pub fn column(comptime field: []const u8) ColumnMetadata {
+            return comptime for (columns) |c| {
+                if (std.mem.eql(u8, c.field, field)) break c;
+            } else @compileError("no column is held by field " ++ field);
+        }
+    };
+};
+
+pub const User = struct {
+    __allocator: Allocator,
+
+    id: i64,
+    name: []const u8,
+    email: []const u8,
+    password: []const u8,
+    salary: ?f64,
+    notes: ?[]const u8,
+    created_at: i64,
+    updated_at: i64,
+    archived_at: ?i64,
+
+    pub fn deinit(self: *const User) void {
+        self.__allocator.free(self.name);
+        self.__allocator.free(self.email);
+        self.__allocator.free(self.password);
+        if (self.notes) |field| {
+            self.__allocator.free(field);
+        }
+    }
+
+    // The table the struct is read from and its columns, in the order of the
+    // struct fields.
+    pub const metadata = struct {
+        pub const table_name = "users";
+        pub const schema = "main";
+        pub const columns = [_]ColumnMetadata{
+            .{ .name = "id", .field = "id", .sql_type = "INTEGER", .nullable = false, .array = false, .primary_key = true },
+            .{ .name = "name", .field = "name", .sql_type = "TEXT", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "email", .field = "email", .sql_type = "TEXT", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "password", .field = "password", .sql_type = "TEXT", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "salary", .field = "salary", .sql_type = "NUMERIC(10,2)", .nullable = true, .array = false, .primary_key = false },
+            .{ .name = "notes", .field = "notes", .sql_type = "TEXT", .nullable = true, .array = false, .primary_key = false },
+            .{ .name = "created_at", .field = "created_at", .sql_type = "TIMESTAMP", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "updated_at", .field = "updated_at", .sql_type = "TIMESTAMP", .nullable = false, .array = false, .primary_key = false },
+            .{ .name = "archived_at", .field = "archived_at", .sql_type = "TIMESTAMP", .nullable = true, .array = false, .primary_key = false },
+        };
+
+        // Returns the metadata of the column held by a struct field.
+        pub fn column(comptime field: []const u8) ColumnMetadata {
+            return comptime for (columns) |c| {
+                if (std.mem.eql(u8, c.field, field)) break c;
+            } else @compileError("no column is held by field " ++ field);
+        }
+    };
+};
+
+// Describes a column of the table a model is read from.
+pub const ColumnMetadata = struct {
+    // The name of the column in SQL
+    name: []const u8,
+    // The name of the struct field holding the column
+    field: []const u8,
+    // The SQL type of the column, or of the items of array columns
+    sql_type: []const u8,
+    nullable: bool,
+    array: bool,
+    primary_key: bool,
+};
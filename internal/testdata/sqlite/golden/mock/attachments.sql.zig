@@ -0,0 +1,235 @@
+// Generated with sqlc v1.28.0
+ 
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const zqlite = @import("zqlite");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(zqlite.Conn);
+pub const PoolQuerier = Querier(*zqlite.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct{
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+
+        pub fn interface(self: *Self) QuerierInterface(T) {
+            return QuerierInterface(T).init(Self, self);
+        }
+        
+        const delete_attachment_sql = 
+            \\DELETE FROM attachments WHERE id = ?
+        ;
+
+        pub fn deleteAttachment(self: Self, id: i64) !void {
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+
+            try conn.exec(delete_attachment_sql, .{ 
+                id,
+            });
+        }
+
+        const get_attachment_sql = 
+            \\SELECT id, user_id, name, data, thumbnail FROM attachments
+            \\WHERE id = ?
+        ;
+
+        pub fn getAttachment(self: Self, id: i64) !models.Attachment {
+            const allocator = self.allocator;
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+
+            var rows = try conn.rows(get_attachment_sql, .{ 
+                id,
+            });
+            defer rows.deinit();
+            if (rows.err) |err| {
+                return err;
+            }
+            const row = rows.next() orelse return error.NotFound;
+
+            const row_id = row.int(0);
+            const row_user_id = row.int(1);
+            const row_name = try allocator.dupe(u8, row.text(2));
+            errdefer allocator.free(row_name);
+            const row_data = try allocator.dupe(u8, row.blob(3));
+            errdefer allocator.free(row_data);
+
+            const maybe_thumbnail = row.nullableBlob(4);
+            const row_thumbnail: ?zqlite.Blob = blk: {
+                if (maybe_thumbnail) |field| {
+                    break :blk try allocator.dupe(u8, field);
+                }
+                break :blk null;
+            };
+            errdefer if (row_thumbnail) |field| {
+                allocator.free(field);
+            };
+
+            return .{
+                .__allocator = allocator,
+                .id = row_id,
+                .user_id = row_user_id,
+                .name = row_name,
+                .data = row_data,
+                .thumbnail = row_thumbnail,
+            };
+        }
+
+    };
+}
+
+pub const ConnQuerierInterface = QuerierInterface(zqlite.Conn);
+pub const PoolQuerierInterface = QuerierInterface(*zqlite.Pool);
+
+// A type erased interface over the methods of Querier(T). It can be backed by
+// a Querier or any other type declaring the same methods, such as a mock.
+// Methods taking a context are not part of the interface.
+pub fn QuerierInterface(comptime T: type) type {
+    return struct {
+        const Self = @This();
+
+        ptr: *anyopaque,
+        vtable: *const VTable,
+
+        pub const VTable = struct {
+            deleteAttachment: *const fn (ptr: *anyopaque, id: i64) anyerror!void,
+            getAttachment: *const fn (ptr: *anyopaque, id: i64) anyerror!models.Attachment,
+        };
+
+        // Returns an interface calling the methods of impl.
+        pub fn init(comptime Impl: type, impl: *Impl) Self {
+            return .{ .ptr = impl, .vtable = &VTableFor(Impl).vtable };
+        }
+
+        fn VTableFor(comptime Impl: type) type {
+            return struct {
+                const vtable = VTable{
+                    .deleteAttachment = struct {
+                        fn call(ptr: *anyopaque, id: i64) anyerror!void {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.deleteAttachment(id);
+                        }
+                    }.call,
+                    .getAttachment = struct {
+                        fn call(ptr: *anyopaque, id: i64) anyerror!models.Attachment {
+                            const impl: *Impl = @ptrCast(@alignCast(ptr));
+                            return impl.getAttachment(id);
+                        }
+                    }.call,
+                };
+            };
+        }
+
+        pub fn deleteAttachment(self: Self, id: i64) anyerror!void {
+            return self.vtable.deleteAttachment(self.ptr, id);
+        }
+
+        pub fn getAttachment(self: Self, id: i64) anyerror!models.Attachment {
+            return self.vtable.getAttachment(self.ptr, id);
+        }
+    };
+}
+
+pub const ConnMockQuerier = MockQuerier(zqlite.Conn);
+pub const PoolMockQuerier = MockQuerier(*zqlite.Pool);
+
+// Records the calls to a single MockQuerier method and the result to return.
+pub fn MockMethod(comptime Args: type, comptime Result: type) type {
+    return struct {
+        // The value returned by the method. Methods returning void succeed
+        // without a result.
+        result: ?Result = null,
+        // An error returned by the method instead of the result.
+        err: ?anyerror = null,
+        // Computes the result of the method from its arguments. Takes
+        // precedence over result and err when set.
+        handler: ?*const fn (args: Args) anyerror!Result = null,
+        // The number of calls expected by MockQuerier.verify, or null to
+        // accept any number of calls.
+        expected_calls: ?usize = null,
+        // The number of times the method was called.
+        calls: usize = 0,
+        // The arguments of the most recent call.
+        last_args: ?Args = null,
+
+        fn call(self: *@This(), args: Args) anyerror!Result {
+            self.calls += 1;
+            self.last_args = args;
+            if (self.handler) |handler| {
+                return handler(args);
+            }
+            if (self.err) |err| {
+                return err;
+            }
+            if (Result == void) {
+                return;
+            }
+            return self.result orelse error.UnexpectedCall;
+        }
+    };
+}
+
+// A mock implementation of the methods of Querier(T) for unit tests. Canned
+// results and expectations are set per method on the expect field, calls
+// without a result set return error.UnexpectedCall unless the method returns
+// void.
+pub fn MockQuerier(comptime T: type) type {
+    return struct {
+        const Self = @This();
+
+        expect: struct {
+            deleteAttachment: MockMethod(struct { id: i64 }, void) = .{},
+            getAttachment: MockMethod(struct { id: i64 }, models.Attachment) = .{},
+        } = .{},
+
+        pub fn interface(self: *Self) QuerierInterface(T) {
+            return QuerierInterface(T).init(Self, self);
+        }
+
+        // Returns error.UnexpectedCallCount if any method with expected_calls
+        // set was called a different number of times.
+        pub fn verify(self: Self) !void {
+            inline for (std.meta.fields(@TypeOf(self.expect))) |field| {
+                const method = @field(self.expect, field.name);
+                if (method.expected_calls) |expected| {
+                    if (method.calls != expected) {
+                        return error.UnexpectedCallCount;
+                    }
+                }
+            }
+        }
+
+        pub fn deleteAttachment(self: *Self, id: i64) anyerror!void {
+            return self.expect.deleteAttachment.call(.{ .id = id });
+        }
+
+        pub fn getAttachment(self: *Self, id: i64) anyerror!models.Attachment {
+            return self.expect.getAttachment.call(.{ .id = id });
+        }
+    };
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+// Generated with sqlc v1.28.0
+
+pub const Attachment = @import("attachment.model.zig").Attachment;
+pub const User = @import("user.model.zig").User;
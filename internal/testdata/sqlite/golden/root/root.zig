@@ -0,0 +1,76 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const zqlite = @import("zqlite");
+
+pub const models = @import("models.zig");
+pub const Attachment = models.Attachment;
+pub const User = models.User;
+
+pub const attachments = @import("attachments.sql.zig");
+pub const users = @import("users.sql.zig");
+
+pub const ConnQuerier = Querier(zqlite.Conn, NoHooks);
+pub const PoolQuerier = Querier(*zqlite.Pool, NoHooks);
+
+pub const NoHooks = struct {};
+
+// Runs the queries of all queries files, holding the Querier of each file in a
+// field named after it.
+pub fn Querier(comptime T: type, comptime Hooks: type) type {
+    return struct {
+        const Self = @This();
+
+        attachments: attachments.Querier(T, Hooks),
+        users: users.Querier(T, Hooks),
+
+        pub fn init(conn: T) Self {
+            return .{
+                .attachments = attachments.Querier(T, Hooks).init(conn),
+                .users = users.Querier(T, Hooks).init(conn),
+            };
+        }
+
+        pub fn deleteAttachment(self: Self, id: i64) !void {
+            return self.attachments.deleteAttachment(id);
+        }
+
+        pub fn getAttachment(self: Self, allocator: Allocator, id: i64) !models.Attachment {
+            return self.attachments.getAttachment(allocator, id);
+        }
+
+        pub fn createUser(self: Self, create_user_params: users.Querier(T, Hooks).CreateUserParams) !void {
+            return self.users.createUser(create_user_params);
+        }
+
+        pub fn getUser(self: Self, allocator: Allocator, id: i64) !models.User {
+            return self.users.getUser(allocator, id);
+        }
+
+        pub fn getUserEmails(self: Self, allocator: Allocator) ![]users.Querier(T, Hooks).GetUserEmailsRow {
+            return self.users.getUserEmails(allocator);
+        }
+
+        pub fn freeGetUserEmails(self: Self, allocator: Allocator, rows: []const users.Querier(T, Hooks).GetUserEmailsRow) void {
+            self.users.freeGetUserEmails(allocator, rows);
+        }
+
+        pub fn getUserIDByEmail(self: Self, email: []const u8) !i64 {
+            return self.users.getUserIDByEmail(email);
+        }
+
+        pub fn getUserIDsBySalaryRange(self: Self, allocator: Allocator, salary_1: f64, salary_2: f64) ![]i64 {
+            return self.users.getUserIDsBySalaryRange(allocator, salary_1, salary_2);
+        }
+
+        pub fn getUsers(self: Self, allocator: Allocator) ![]models.User {
+            return self.users.getUsers(allocator);
+        }
+
+        pub fn freeGetUsers(self: Self, allocator: Allocator, rows: []const models.User) void {
+            self.users.freeGetUsers(allocator, rows);
+        }
+    };
+}
@@ -0,0 +1,60 @@
+// Generated with sqlc v1.28.0
+
+const std = @import("std");
+
+const zqlite = @import("zqlite");
+
+pub const File = struct {
+    // The path of the file relative to the sqlc configuration
+    name: []const u8,
+    sql: [:0]const u8,
+};
+
+// The schema files read by sqlc, in the order they are applied.
+pub const files = [_]File{
+    .{ .name = "schema.sql", .sql = @embedFile("schema.sql") },
+};
+
+const version_table_sql = "CREATE TABLE IF NOT EXISTS sqlc_schema_version (checksum TEXT NOT NULL, applied_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP)";
+
+// Returns the hex encoded SHA-256 checksum of the schema files.
+pub fn checksum() [64]u8 {
+    var hasher = std.crypto.hash.sha2.Sha256.init(.{});
+    for (files) |file| {
+        hasher.update(file.sql);
+    }
+    return std.fmt.bytesToHex(hasher.finalResult(), .lower);
+}
+
+// Applies the schema files in a transaction and records their checksum in the
+// sqlc_schema_version table. Nothing is applied when the checksum is already
+// recorded, and error.SchemaChanged is returned when a different one is.
+pub fn migrate(conn: zqlite.Conn) !void {
+    const sum = checksum();
+    try conn.exec("BEGIN", .{});
+    errdefer conn.exec("ROLLBACK", .{}) catch {};
+    try conn.exec(version_table_sql, .{});
+    const recorded: ?bool = blk: {
+        var rows = try conn.rows("SELECT checksum FROM sqlc_schema_version", .{});
+        defer rows.deinit();
+        const row = rows.next() orelse {
+            if (rows.err) |err| {
+                return err;
+            }
+            break :blk null;
+        };
+        break :blk std.mem.eql(u8, row.text(0), &sum);
+    };
+    if (recorded) |same| {
+        if (!same) {
+            return error.SchemaChanged;
+        }
+        return conn.exec("COMMIT", .{});
+    }
+    for (files) |file| {
+        // exec only runs the first statement of the SQL it is given
+        try conn.execNoArgs(file.sql);
+    }
+    try conn.exec("INSERT INTO sqlc_schema_version (checksum) VALUES (?1)", .{@as([]const u8, &sum)});
+    try conn.exec("COMMIT", .{});
+}
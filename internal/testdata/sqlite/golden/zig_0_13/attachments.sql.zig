@@ -0,0 +1,135 @@
+// Generated with sqlc v1.28.0
+ 
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const zqlite = @import("zqlite");
+const models = @import("models.zig");
+
+pub const ConnQuerier = Querier(zqlite.Conn);
+pub const PoolQuerier = Querier(*zqlite.Pool);
+
+pub fn Querier(comptime T: type) type {
+    return struct{
+        const Self = @This();
+        
+        allocator: Allocator,
+        conn: T,
+
+        pub fn init(allocator: Allocator, conn: T) Self {
+            return .{ .allocator = allocator, .conn = conn };
+        }
+        
+        const delete_attachment_sql = 
+            \\DELETE FROM attachments WHERE id = ?
+        ;
+
+        pub fn deleteAttachment(self: Self, id: i64) !void {
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+            var deadline = Deadline.init(1000);
+            deadline.install(conn);
+            defer Deadline.uninstall(conn);
+
+            conn.exec(delete_attachment_sql, .{ 
+                id,
+            }) catch |err| return timeoutError(err);
+        }
+
+        const get_attachment_sql = 
+            \\SELECT id, user_id, name, data, thumbnail FROM attachments
+            \\WHERE id = ?
+        ;
+
+        pub fn getAttachment(self: Self, id: i64) !models.Attachment {
+            const allocator = self.allocator;
+            var conn: zqlite.Conn = blk: {
+                if (T == *zqlite.Pool) {
+                    break :blk self.conn.acquire();
+                } else {
+                    break :blk self.conn;
+                }
+            };
+            defer if (T == *zqlite.Pool) {
+                conn.release();
+            };
+            var deadline = Deadline.init(1000);
+            deadline.install(conn);
+            defer Deadline.uninstall(conn);
+
+            var rows = conn.rows(get_attachment_sql, .{ 
+                id,
+            }) catch |err| return timeoutError(err);
+            defer rows.deinit();
+            if (rows.err) |err| {
+                return timeoutError(err);
+            }
+            const row = rows.next() orelse return error.NotFound;
+
+            const row_id = row.int(0);
+            const row_user_id = row.int(1);
+            const row_name = try allocator.dupe(u8, row.text(2));
+            errdefer allocator.free(row_name);
+            const row_data = try allocator.dupe(u8, row.blob(3));
+            errdefer allocator.free(row_data);
+
+            const maybe_thumbnail = row.nullableBlob(4);
+            const row_thumbnail: ?zqlite.Blob = blk: {
+                if (maybe_thumbnail) |field| {
+                    break :blk try allocator.dupe(u8, field);
+                }
+                break :blk null;
+            };
+            errdefer if (row_thumbnail) |field| {
+                allocator.free(field);
+            };
+
+            return .{
+                .__allocator = allocator,
+                .id = row_id,
+                .user_id = row_user_id,
+                .name = row_name,
+                .data = row_data,
+                .thumbnail = row_thumbnail,
+            };
+        }
+
+    };
+}
+
+// Interrupts the statements running on a connection once the deadline has
+// passed, using the sqlite progress handler.
+const Deadline = struct {
+    at: i64,
+
+    fn init(timeout_ms: i64) Deadline {
+        return .{ .at = std.time.milliTimestamp() + timeout_ms };
+    }
+
+    fn install(self: *Deadline, conn: zqlite.Conn) void {
+        zqlite.c.sqlite3_progress_handler(conn.conn, 1000, interrupt, self);
+    }
+
+    fn uninstall(conn: zqlite.Conn) void {
+        zqlite.c.sqlite3_progress_handler(conn.conn, 0, null, null);
+    }
+
+    fn interrupt(ptr: ?*anyopaque) callconv(.C) c_int {
+        const self: *Deadline = @ptrCast(@alignCast(ptr));
+        return @intFromBool(std.time.milliTimestamp() >= self.at);
+    }
+};
+
+// Returns error.Timeout in place of err if the query was interrupted by its
+// deadline.
+fn timeoutError(err: anytype) @TypeOf(err) || error{Timeout} {
+    return if (@as(anyerror, err) == error.Interrupt) error.Timeout else err;
+}
\ No newline at end of file
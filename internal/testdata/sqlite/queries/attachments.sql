@@ -0,0 +1,6 @@
+-- name: GetAttachment :one
+SELECT * FROM attachments
+WHERE id = ?;
+
+-- name: DeleteAttachment :exec
+DELETE FROM attachments WHERE id = ?;
@@ -1,6 +1,7 @@
 package zig
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 
@@ -77,3 +78,40 @@ func snakeCase(name string) string {
 	snake = matchAllCap.ReplaceAllString(snake, "${1}_${2}")
 	return strings.ToLower(snake)
 }
+
+// unknownKeyError reports an unrecognized key, suggesting the closest of the
+// known keys when there is one that looks like a typo.
+func unknownKeyError(kind, key string, known []string) error {
+	var suggestion string
+	best := -1
+	for _, candidate := range known {
+		dist := levenshtein(key, candidate)
+		if dist <= max(2, len(candidate)/3) && (best < 0 || dist < best) {
+			suggestion, best = candidate, dist
+		}
+	}
+	if suggestion != "" {
+		return fmt.Errorf("unknown %s %q, did you mean %q?", kind, key, suggestion)
+	}
+	return fmt.Errorf("unknown %s %q", kind, key)
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,16 @@
+const std = @import("std");
+
+const everything = @import("gen/everything/root.zig");
+const fixtures = @import("gen/everything/fixtures.zig");
+const catalog = @import("gen/everything/queries.zig");
+
+// Only referenced declarations are analyzed, so the generic queriers without a
+// concrete alias are instantiated here to compile their methods too.
+test "postgres(everything): code generated with every option compiles" {
+    std.testing.refAllDeclsRecursive(everything);
+    std.testing.refAllDeclsRecursive(fixtures);
+    std.testing.refAllDeclsRecursive(catalog);
+    inline for (.{ everything.orders, everything.users }) |file| {
+        std.testing.refAllDeclsRecursive(file.RoutedQuerier(file.NoHooks));
+    }
+}
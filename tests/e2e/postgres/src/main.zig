@@ -2,7 +2,9 @@ const std = @import("std");
 
 pub const ContextTests = @import("context.zig");
 pub const ContextUnionTests = @import("contextunions.zig");
+pub const EverythingTests = @import("everything.zig");
 pub const ManagedTests = @import("managed.zig");
+pub const TimeoutTests = @import("timeouts.zig");
 pub const UnionTests = @import("unions.zig");
 pub const UnmanagedTests = @import("unmanaged.zig");
 
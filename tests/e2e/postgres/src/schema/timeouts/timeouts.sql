@@ -0,0 +1,5 @@
+-- name: Sleep :exec
+SELECT pg_sleep(sqlc.arg(seconds)::float8);
+
+-- name: CountUsers :one
+SELECT count(*) FROM users;
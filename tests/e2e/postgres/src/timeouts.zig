@@ -0,0 +1,62 @@
+const std = @import("std");
+const Allocator = std.mem.Allocator;
+
+const pg = @import("pg");
+
+const TimeoutQueries = @import("gen/timeouts/timeouts.sql.zig");
+const TimeoutQuerier = TimeoutQueries.ConnQuerier;
+const TestDB = @import("testdb.zig");
+
+fn expectStatementTimeout(conn: *pg.Conn, expected: []const u8) !void {
+    var row = (try conn.row("SHOW statement_timeout", .{})) orelse return error.NotFound;
+    defer row.deinit() catch {};
+    try std.testing.expectEqualStrings(expected, row.get([]const u8, 0));
+}
+
+test "postgres(timeouts): queries outside a transaction" {
+    const expectEqual = std.testing.expectEqual;
+    const expectError = std.testing.expectError;
+    const allocator = std.testing.allocator;
+
+    var test_db = try TestDB.init(allocator);
+    defer test_db.deinit();
+
+    const conn = try test_db.pool.acquire();
+    defer test_db.pool.release(conn);
+    const querier = TimeoutQuerier.init(allocator, conn);
+
+    try querier.sleep(0);
+    try expectStatementTimeout(conn, "0");
+
+    try expectError(error.Timeout, querier.sleep(1));
+    try expectStatementTimeout(conn, "0");
+
+    try expectEqual(0, try querier.countUsers());
+}
+
+test "postgres(timeouts): queries inside a transaction" {
+    const expectEqual = std.testing.expectEqual;
+    const expectError = std.testing.expectError;
+    const allocator = std.testing.allocator;
+
+    var test_db = try TestDB.init(allocator);
+    defer test_db.deinit();
+
+    const conn = try test_db.pool.acquire();
+    defer test_db.pool.release(conn);
+    const querier = TimeoutQuerier.init(allocator, conn);
+
+    try conn.begin();
+    try querier.sleep(0);
+    try expectStatementTimeout(conn, "0");
+    try conn.commit();
+
+    // The timeout aborts the transaction, rolling it back restores the
+    // statement_timeout of the connection
+    try conn.begin();
+    try expectError(error.Timeout, querier.sleep(1));
+    try conn.rollback();
+    try expectStatementTimeout(conn, "0");
+
+    try expectEqual(0, try querier.countUsers());
+}
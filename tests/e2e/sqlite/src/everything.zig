@@ -0,0 +1,11 @@
+const std = @import("std");
+
+const everything = @import("gen/everything/root.zig");
+const fixtures = @import("gen/everything/fixtures.zig");
+const catalog = @import("gen/everything/queries.zig");
+
+test "sqlite(everything): code generated with every option compiles" {
+    std.testing.refAllDeclsRecursive(everything);
+    std.testing.refAllDeclsRecursive(fixtures);
+    std.testing.refAllDeclsRecursive(catalog);
+}
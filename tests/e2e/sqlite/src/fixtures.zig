@@ -0,0 +1,24 @@
+const std = @import("std");
+
+const zqlite = @import("zqlite");
+
+const fixtures = @import("gen/fixtures/fixtures.zig");
+
+const schema = @embedFile("schema/schema.sql");
+
+test "sqlite(fixtures): insert leaves out the rowid" {
+    const expectEqual = std.testing.expectEqual;
+
+    const conn = try zqlite.open(":memory:", zqlite.OpenFlags.Create | zqlite.OpenFlags.EXResCode);
+    defer conn.close();
+    try conn.execNoArgs(schema);
+
+    try (fixtures.User{ .email = "first@example.com" }).insert(conn);
+    try (fixtures.User{ .email = "second@example.com" }).insert(conn);
+    try (fixtures.Session{ .user_id = 1, .token = "first" }).insert(conn);
+    try (fixtures.Session{ .user_id = 2, .token = "second" }).insert(conn);
+
+    const row = (try conn.row("SELECT MAX(id) FROM users", .{})) orelse unreachable;
+    defer row.deinit();
+    try expectEqual(2, row.int(0));
+}
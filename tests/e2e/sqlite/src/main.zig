@@ -1,7 +1,10 @@
 const std = @import("std");
 
 pub const ContextTests = @import("context.zig");
+pub const EverythingTests = @import("everything.zig");
+pub const FixturesTests = @import("fixtures.zig");
 pub const ManagedTests = @import("managed.zig");
+pub const SchemaTests = @import("schema.zig");
 pub const UnmanagedTests = @import("unmanaged.zig");
 
 test {
@@ -0,0 +1,20 @@
+const std = @import("std");
+
+const zqlite = @import("zqlite");
+
+const schema = @import("gen/schema/schema.zig");
+
+test "sqlite(schema): migrate applies every statement" {
+    const expectEqual = std.testing.expectEqual;
+
+    const conn = try zqlite.open(":memory:", zqlite.OpenFlags.Create | zqlite.OpenFlags.EXResCode);
+    defer conn.close();
+
+    try schema.migrate(conn);
+    // A second migrate finds the checksum recorded and does nothing
+    try schema.migrate(conn);
+
+    const row = (try conn.row("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name IN ('users', 'sessions')", .{})) orelse unreachable;
+    defer row.deinit();
+    try expectEqual(2, row.int(0));
+}
@@ -10,3 +10,9 @@ CREATE TABLE users (
     archived_at TIMESTAMP
 );
 
+
+CREATE TABLE sessions (
+    id INTEGER PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id),
+    token TEXT NOT NULL UNIQUE
+);
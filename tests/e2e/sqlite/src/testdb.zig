@@ -29,7 +29,7 @@ pub fn init(allocator: Allocator) !TestDB {
     const c = pool.acquire();
     defer c.release();
 
-    try c.exec(schema, .{});
+    try c.execNoArgs(schema);
 
     return .{
         .allocator = allocator,